@@ -0,0 +1,66 @@
+package game
+
+import "strings"
+
+// Ball is a Poké Ball type, named after its PokeAPI item resource.
+type Ball string
+
+const (
+	PokeBall   Ball = "poke-ball"
+	GreatBall  Ball = "great-ball"
+	UltraBall  Ball = "ultra-ball"
+	MasterBall Ball = "master-ball"
+)
+
+var Balls = []Ball{PokeBall, GreatBall, UltraBall, MasterBall}
+
+// Bonus is the ball's catch rate modifier. The Master Ball's is large
+// enough to make the catch value always reach 255.
+func (b Ball) Bonus() float64 {
+	switch b {
+	case GreatBall:
+		return 1.5
+	case UltraBall:
+		return 2
+	case MasterBall:
+		return 255
+	}
+	return PokeBallBonus
+}
+
+// ParseBall accepts "great", "great-ball", "greatball" or "great ball".
+func ParseBall(name string) (Ball, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.TrimSuffix(strings.TrimSuffix(strings.ReplaceAll(name, " ", "-"), "ball"), "-")
+	for _, b := range Balls {
+		if string(b) == name+"-ball" {
+			return b, true
+		}
+	}
+	return "", false
+}
+
+// Inventory counts the balls a player is carrying.
+type Inventory map[Ball]int
+
+func StartingInventory() Inventory {
+	return Inventory{
+		PokeBall:   20,
+		GreatBall:  10,
+		UltraBall:  5,
+		MasterBall: 1,
+	}
+}
+
+// Use takes one ball out of the inventory, reporting false if there is none.
+func (inv Inventory) Use(b Ball) bool {
+	if inv[b] <= 0 {
+		return false
+	}
+	inv[b]--
+	return true
+}
+
+func (inv Inventory) Add(b Ball, n int) {
+	inv[b] += n
+}
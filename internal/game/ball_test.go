@@ -0,0 +1,63 @@
+package game
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParseBall(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected Ball
+		ok       bool
+	}{
+		{input: "great", expected: GreatBall, ok: true},
+		{input: "great-ball", expected: GreatBall, ok: true},
+		{input: "Ultra Ball", expected: UltraBall, ok: true},
+		{input: "masterball", expected: MasterBall, ok: true},
+		{input: "poke", expected: PokeBall, ok: true},
+		{input: "premier", expected: "", ok: false},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			ball, ok := ParseBall(c.input)
+			if ball != c.expected || ok != c.ok {
+				t.Errorf("ParseBall(%q) = %q, %v, expected %q, %v", c.input, ball, ok, c.expected, c.ok)
+			}
+		})
+	}
+}
+
+func TestInventory(t *testing.T) {
+	inv := Inventory{MasterBall: 1}
+	if !inv.Use(MasterBall) {
+		t.Errorf("expected to use a master ball")
+	}
+	if inv.Use(MasterBall) {
+		t.Errorf("expected no master balls left")
+	}
+	if inv.Use(GreatBall) {
+		t.Errorf("expected no great balls")
+	}
+}
+
+func TestItems(t *testing.T) {
+	items := Items{"fire-stone": 1}
+	if !items.Use("fire-stone") {
+		t.Errorf("expected to use a fire stone")
+	}
+	if items.Use("fire-stone") {
+		t.Errorf("expected no fire stones left")
+	}
+	if items.Use("moon-stone") {
+		t.Errorf("expected no moon stones")
+	}
+}
+
+func TestMasterBallAlwaysCatches(t *testing.T) {
+	result := Catch(testRand(), CatchAttempt{MaxHP: 200, CurrentHP: 200, CaptureRate: 3, BallBonus: MasterBall.Bonus()})
+	if !result.Caught {
+		t.Errorf("expected master ball to always catch")
+	}
+}
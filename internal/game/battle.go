@@ -0,0 +1,200 @@
+package game
+
+import (
+	"math/rand/v2"
+	"slices"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+// MaxMoves is how many moves a Pokémon can know.
+const MaxMoves = 4
+
+// CriticalChance is the one-in-n chance of a critical hit.
+const CriticalChance = 24
+
+// Move is a damaging move as used in battle. An Accuracy of 0 never misses
+// and Class is "physical" or "special".
+type Move struct {
+	Name     string
+	Type     string
+	Class    string
+	Power    int
+	Accuracy int
+	Priority int
+}
+
+// Struggle is used by Pokémon that know no damaging moves. It has no type,
+// so it gets neither STAB nor type effectiveness.
+var Struggle = Move{Name: "struggle", Class: "physical", Power: 50}
+
+// MoveFrom converts a move from the API. It reports false for status moves,
+// which deal no damage.
+func MoveFrom(m pokeapi.MoveResponse) (Move, bool) {
+	if m.Power == nil || *m.Power == 0 || m.DamageClass.Name == "status" {
+		return Move{}, false
+	}
+	move := Move{
+		Name:     m.Name,
+		Type:     m.Type.Name,
+		Class:    m.DamageClass.Name,
+		Power:    *m.Power,
+		Priority: m.Priority,
+	}
+	if m.Accuracy != nil {
+		move.Accuracy = *m.Accuracy
+	}
+	return move, true
+}
+
+// Battler is a Pokémon taking part in a battle.
+type Battler struct {
+	Name           string
+	Level          int
+	Types          []string
+	MaxHP          int
+	HP             int
+	Attack         int
+	Defense        int
+	SpecialAttack  int
+	SpecialDefense int
+	Speed          int
+	Moves          []Move
+}
+
+// NewBattler prepares a Pokémon at level with full HP, without individual
+// or effort values or a nature.
+func NewBattler(p pokeapi.PokemonResponse, level int, moves []Move) *Battler {
+	return newBattler(p.Name, p, level, Stats(p, level, nil, nil, pokeapi.NatureResponse{}), moves)
+}
+
+// NewOwnedBattler prepares an owned Pokémon with full HP, using its own
+// stats and nickname.
+func NewOwnedBattler(o *Owned, nature pokeapi.NatureResponse, moves []Move) *Battler {
+	return newBattler(o.Name(), o.Pokemon, o.Level, o.Stats(nature), moves)
+}
+
+func newBattler(name string, p pokeapi.PokemonResponse, level int, stats map[string]int, moves []Move) *Battler {
+	return &Battler{
+		Name:           name,
+		Level:          level,
+		Types:          p.TypesOf(),
+		MaxHP:          stats["hp"],
+		HP:             stats["hp"],
+		Attack:         stats["attack"],
+		Defense:        stats["defense"],
+		SpecialAttack:  stats["special-attack"],
+		SpecialDefense: stats["special-defense"],
+		Speed:          stats["speed"],
+		Moves:          moves,
+	}
+}
+
+func (b *Battler) Fainted() bool {
+	return b.HP <= 0
+}
+
+// Attack is the outcome of one Pokémon using a move.
+type Attack struct {
+	Attacker      *Battler
+	Defender      *Battler
+	Move          Move
+	Missed        bool
+	Critical      bool
+	Effectiveness float64
+	Damage        int
+}
+
+// Damage rolls accuracy, a critical hit and the random factor for move and
+// returns the damage it deals, using the generation V onwards formula with
+// STAB and type effectiveness from chart. It does not change either HP.
+func Damage(rng *rand.Rand, attacker, defender *Battler, move Move, chart pokeapi.TypeChart) Attack {
+	attack := Attack{Attacker: attacker, Defender: defender, Move: move, Effectiveness: 1}
+	if move.Accuracy > 0 && rng.IntN(100) >= move.Accuracy {
+		attack.Missed = true
+		return attack
+	}
+	if move.Type != "" {
+		attack.Effectiveness = chart.Effectiveness(move.Type, defender.Types...)
+	}
+	if attack.Effectiveness == 0 {
+		return attack
+	}
+
+	a, d := attacker.Attack, defender.Defense
+	if move.Class == "special" {
+		a, d = attacker.SpecialAttack, defender.SpecialDefense
+	}
+	damage := float64((2*attacker.Level/5+2)*move.Power*a/max(1, d)/50 + 2)
+
+	attack.Critical = rng.IntN(CriticalChance) == 0
+	if attack.Critical {
+		damage = float64(int(damage * 1.5))
+	}
+	damage = float64(int(damage * float64(85+rng.IntN(16)) / 100))
+	if move.Type != "" && slices.Contains(attacker.Types, move.Type) {
+		damage = float64(int(damage * 1.5))
+	}
+	damage = float64(int(damage * attack.Effectiveness))
+	attack.Damage = max(1, int(damage))
+	return attack
+}
+
+// Battle is a one-on-one fight between two Pokémon.
+type Battle struct {
+	A     *Battler
+	B     *Battler
+	Chart pokeapi.TypeChart
+	Turns int
+}
+
+func (b *Battle) Over() bool {
+	return b.A.Fainted() || b.B.Fainted()
+}
+
+// Winner returns the Pokémon left standing, or nil while the battle is on.
+func (b *Battle) Winner() *Battler {
+	switch {
+	case b.B.Fainted():
+		return b.A
+	case b.A.Fainted():
+		return b.B
+	}
+	return nil
+}
+
+func chooseMove(rng *rand.Rand, b *Battler) Move {
+	if len(b.Moves) == 0 {
+		return Struggle
+	}
+	return b.Moves[rng.IntN(len(b.Moves))]
+}
+
+// Turn plays one turn: both Pokémon pick a random move and the one with the
+// higher priority, then speed, attacks first. Speed ties are broken at
+// random. A Pokémon that faints before its turn does not attack.
+func (b *Battle) Turn(rng *rand.Rand) []Attack {
+	b.Turns++
+	first, second := b.A, b.B
+	firstMove, secondMove := chooseMove(rng, b.A), chooseMove(rng, b.B)
+	swap := secondMove.Priority > firstMove.Priority ||
+		secondMove.Priority == firstMove.Priority && (second.Speed > first.Speed || second.Speed == first.Speed && rng.IntN(2) == 0)
+	if swap {
+		first, second = second, first
+		firstMove, secondMove = secondMove, firstMove
+	}
+
+	attacks := []Attack{}
+	for _, turn := range []struct {
+		attacker, defender *Battler
+		move               Move
+	}{{first, second, firstMove}, {second, first, secondMove}} {
+		if b.Over() {
+			break
+		}
+		attack := Damage(rng, turn.attacker, turn.defender, turn.move, b.Chart)
+		turn.defender.HP = max(0, turn.defender.HP-attack.Damage)
+		attacks = append(attacks, attack)
+	}
+	return attacks
+}
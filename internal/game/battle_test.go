@@ -0,0 +1,84 @@
+package game
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+var testChart = pokeapi.TypeChart{
+	"electric": {"water": 2, "ground": 0, "electric": 0.5},
+	"water":    {"electric": 1},
+}
+
+func testBattler(name string, types ...string) *Battler {
+	return &Battler{
+		Name: name, Level: 50, Types: types,
+		MaxHP: 100, HP: 100,
+		Attack: 100, Defense: 100, SpecialAttack: 100, SpecialDefense: 100, Speed: 100,
+	}
+}
+
+func TestDamage(t *testing.T) {
+	thunderbolt := Move{Name: "thunderbolt", Type: "electric", Class: "special", Power: 90, Accuracy: 100}
+
+	cases := []struct {
+		attacker *Battler
+		defender *Battler
+		min, max int
+	}{
+		// (22*90*100/100)/50+2 = 41, times 0.85-1.0, a 1.5 critical, 1.5 STAB and 2x.
+		{testBattler("pikachu", "electric"), testBattler("squirtle", "water"), 102, 182},
+		{testBattler("ditto", "normal"), testBattler("squirtle", "water"), 68, 122},
+		{testBattler("pikachu", "electric"), testBattler("geodude", "ground"), 0, 0},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			rng := testRand()
+			for range 100 {
+				attack := Damage(rng, c.attacker, c.defender, thunderbolt, testChart)
+				if attack.Damage < c.min || attack.Damage > c.max {
+					t.Fatalf("expected damage in %d-%d, got %d", c.min, c.max, attack.Damage)
+				}
+			}
+		})
+	}
+}
+
+func TestDamageMisses(t *testing.T) {
+	rng := testRand()
+	never := Move{Name: "never", Type: "water", Class: "physical", Power: 40, Accuracy: 1}
+	misses := 0
+	for range 100 {
+		if Damage(rng, testBattler("a"), testBattler("b"), never, nil).Missed {
+			misses++
+		}
+	}
+	if misses < 90 {
+		t.Errorf("expected a 1%% accurate move to miss most of the time, missed %d times", misses)
+	}
+}
+
+func TestBattle(t *testing.T) {
+	fast := testBattler("fast", "electric")
+	fast.Speed = 200
+	fast.Moves = []Move{{Name: "thunderbolt", Type: "electric", Class: "special", Power: 90}}
+	slow := testBattler("slow", "water")
+
+	battle := &Battle{A: slow, B: fast, Chart: testChart}
+	rng := testRand()
+	for !battle.Over() && battle.Turns < 100 {
+		attacks := battle.Turn(rng)
+		if attacks[0].Attacker != fast {
+			t.Fatalf("expected the faster Pokémon to attack first")
+		}
+	}
+	if battle.Winner() != fast {
+		t.Errorf("expected fast to win")
+	}
+	if slow.HP != 0 {
+		t.Errorf("expected the loser to have 0 HP, got %d", slow.HP)
+	}
+}
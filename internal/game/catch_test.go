@@ -0,0 +1,86 @@
+package game
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+func testRand() *rand.Rand {
+	return rand.New(rand.NewPCG(1, 2))
+}
+
+func TestCatchValue(t *testing.T) {
+	cases := []struct {
+		attempt  CatchAttempt
+		expected int
+	}{
+		{
+			attempt:  CatchAttempt{MaxHP: 100, CurrentHP: 100, CaptureRate: 190, BallBonus: PokeBallBonus},
+			expected: 63,
+		},
+		{
+			attempt:  CatchAttempt{MaxHP: 100, CurrentHP: 1, CaptureRate: 190, BallBonus: PokeBallBonus},
+			expected: 188,
+		},
+		{
+			attempt:  CatchAttempt{MaxHP: 100, CurrentHP: 100, CaptureRate: 3, BallBonus: 2, Status: StatusSleep},
+			expected: 4,
+		},
+		{
+			attempt:  CatchAttempt{MaxHP: 100, CurrentHP: 50, CaptureRate: 255, BallBonus: 1.5, Status: StatusParalysis},
+			expected: 382,
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			if v := catchValue(c.attempt); v != c.expected {
+				t.Errorf("expected catch value %d, got %d", c.expected, v)
+			}
+		})
+	}
+}
+
+func TestShakeThreshold(t *testing.T) {
+	if b := shakeThreshold(0); b != 0 {
+		t.Errorf("expected threshold 0, got %d", b)
+	}
+	if b := shakeThreshold(255); b != 65536 {
+		t.Errorf("expected guaranteed threshold, got %d", b)
+	}
+	if b := shakeThreshold(63); b < 46000 || b > 46500 {
+		t.Errorf("expected threshold around 46250, got %d", b)
+	}
+}
+
+func TestGuaranteedCatch(t *testing.T) {
+	result := Catch(testRand(), CatchAttempt{MaxHP: 10, CurrentHP: 1, CaptureRate: 255, BallBonus: 255})
+	if !result.Caught || result.Shakes != 3 {
+		t.Errorf("expected guaranteed catch with 3 shakes, got %+v", result)
+	}
+	result = Catch(testRand(), CatchAttempt{MaxHP: 10, CurrentHP: 10, CaptureRate: 0, BallBonus: PokeBallBonus})
+	if result.Caught || result.Shakes != 0 {
+		t.Errorf("expected certain escape with no shakes, got %+v", result)
+	}
+}
+
+func TestMaxHP(t *testing.T) {
+	if hp := MaxHP(35, 50); hp != 95 {
+		t.Errorf("expected pikachu to have 95 HP at level 50, got %d", hp)
+	}
+}
+
+func TestCatchIsReproducible(t *testing.T) {
+	attempt := CatchAttempt{MaxHP: 100, CurrentHP: 60, CaptureRate: 45, BallBonus: PokeBallBonus}
+	first := rand.New(rand.NewPCG(42, 42))
+	second := rand.New(rand.NewPCG(42, 42))
+
+	for i := 0; i < 20; i++ {
+		a := Catch(first, attempt)
+		b := Catch(second, attempt)
+		if a != b {
+			t.Fatalf("throw %d differs with the same seed: %+v vs %+v", i, a, b)
+		}
+	}
+}
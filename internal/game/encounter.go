@@ -0,0 +1,114 @@
+package game
+
+import (
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+// WildPokemon is a Pokémon met in an encounter, waiting to be caught.
+type WildPokemon struct {
+	Name      string
+	Level     int
+	Method    string
+	Version   string
+	MaxHP     int
+	CurrentHP int
+}
+
+// FilterEncounters keeps the encounters matching version and method. An
+// empty version or method matches any.
+func FilterEncounters(encounters []pokeapi.Encounter, version, method string) []pokeapi.Encounter {
+	filtered := []pokeapi.Encounter{}
+	for _, e := range encounters {
+		if (version == "" || e.Version == version) && (method == "" || e.Method == method) {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
+
+// EncounterTimeOfDay returns the encounter table in use at t: "morning"
+// from 4:00 to 10:00, "day" until 20:00 and "night" otherwise.
+func EncounterTimeOfDay(t time.Time) string {
+	switch hour := t.Hour(); {
+	case hour >= 4 && hour < 10:
+		return "morning"
+	case hour >= 10 && hour < 20:
+		return "day"
+	}
+	return "night"
+}
+
+// Season returns the season of t's month in the northern hemisphere.
+func Season(t time.Time) string {
+	switch t.Month() {
+	case time.March, time.April, time.May:
+		return "spring"
+	case time.June, time.July, time.August:
+		return "summer"
+	case time.September, time.October, time.November:
+		return "autumn"
+	}
+	return "winter"
+}
+
+// ConditionsMet reports whether every condition of an encounter slot holds
+// at now. Conditions describing the default state, such as "swarm-no",
+// "radar-off" or "slot2-none", always hold and the time of day and season
+// follow now. Anything else, like a swarm or the Poké Radar, never does.
+func ConditionsMet(conditions []string, now time.Time) bool {
+	for _, c := range conditions {
+		switch {
+		case strings.HasSuffix(c, "-no"), strings.HasSuffix(c, "-off"), strings.HasSuffix(c, "-none"):
+		case c == "time-"+EncounterTimeOfDay(now), c == "season-"+Season(now):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// AvailableEncounters keeps the encounters whose conditions are met at now.
+func AvailableEncounters(encounters []pokeapi.Encounter, now time.Time) []pokeapi.Encounter {
+	available := []pokeapi.Encounter{}
+	for _, e := range encounters {
+		if ConditionsMet(e.Conditions, now) {
+			available = append(available, e)
+		}
+	}
+	return available
+}
+
+// SampleEncounter picks one of encounters weighted by its chance and rolls a
+// level in its range. The chances only add up within one version's tables,
+// so encounters from other versions than the first one's are ignored. It
+// reports false if no encounter has a chance.
+func SampleEncounter(rng *rand.Rand, encounters []pokeapi.Encounter) (WildPokemon, bool) {
+	if len(encounters) > 0 {
+		encounters = FilterEncounters(encounters, encounters[0].Version, "")
+	}
+	total := 0
+	for _, e := range encounters {
+		total += max(0, e.Chance)
+	}
+	if total == 0 {
+		return WildPokemon{}, false
+	}
+
+	roll := rng.IntN(total)
+	for _, e := range encounters {
+		if roll < max(0, e.Chance) {
+			return WildPokemon{
+				Name:    e.Pokemon,
+				Level:   e.MinLevel + rng.IntN(max(0, e.MaxLevel-e.MinLevel)+1),
+				Method:  e.Method,
+				Version: e.Version,
+			}, true
+		}
+		roll -= max(0, e.Chance)
+	}
+	return WildPokemon{}, false
+}
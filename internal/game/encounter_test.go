@@ -0,0 +1,79 @@
+package game
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+func TestSampleEncounter(t *testing.T) {
+	encounters := []pokeapi.Encounter{
+		{Pokemon: "pidgey", Version: "red", Method: "walk", Chance: 75, MinLevel: 2, MaxLevel: 5},
+		{Pokemon: "rattata", Version: "red", Method: "walk", Chance: 25, MinLevel: 3, MaxLevel: 3},
+		{Pokemon: "magikarp", Version: "red", Method: "old-rod", Chance: 100, MinLevel: 5, MaxLevel: 5},
+		{Pokemon: "sentret", Version: "gold", Method: "walk", Chance: 100, MinLevel: 2, MaxLevel: 2},
+	}
+	walks := FilterEncounters(encounters, "red", "walk")
+	if len(walks) != 2 {
+		t.Fatalf("expected 2 red walk encounters, got %d", len(walks))
+	}
+
+	rng := testRand()
+	counts := map[string]int{}
+	for i := 0; i < 1000; i++ {
+		wild, ok := SampleEncounter(rng, walks)
+		if !ok {
+			t.Fatalf("expected an encounter")
+		}
+		counts[wild.Name]++
+		if wild.Name == "pidgey" && (wild.Level < 2 || wild.Level > 5) {
+			t.Errorf("pidgey level %d out of range", wild.Level)
+		}
+		if wild.Name == "rattata" && wild.Level != 3 {
+			t.Errorf("rattata level %d out of range", wild.Level)
+		}
+	}
+	if counts["pidgey"] < 650 || counts["pidgey"] > 850 {
+		t.Errorf("expected about 750 pidgey encounters, got %d", counts["pidgey"])
+	}
+	if counts["magikarp"] != 0 || counts["sentret"] != 0 {
+		t.Errorf("expected only walk encounters from red, got %v", counts)
+	}
+
+	counts = map[string]int{}
+	for i := 0; i < 100; i++ {
+		wild, _ := SampleEncounter(rng, FilterEncounters(encounters, "", "walk"))
+		counts[wild.Name]++
+	}
+	if counts["sentret"] != 0 {
+		t.Errorf("expected walk encounters from red only, got %v", counts)
+	}
+
+	if _, ok := SampleEncounter(rng, nil); ok {
+		t.Errorf("expected no encounter without candidates")
+	}
+}
+
+func TestConditionsMet(t *testing.T) {
+	morning := time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC)
+	cases := []struct {
+		conditions []string
+		expected   bool
+	}{
+		{nil, true},
+		{[]string{"swarm-no", "radar-off", "slot2-none"}, true},
+		{[]string{"time-morning", "season-summer"}, true},
+		{[]string{"time-night"}, false},
+		{[]string{"swarm-yes"}, false},
+		{[]string{"radar-on", "time-morning"}, false},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			if actual := ConditionsMet(c.conditions, morning); actual != c.expected {
+				t.Errorf("expected %v, got %v", c.expected, actual)
+			}
+		})
+	}
+}
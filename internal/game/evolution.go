@@ -0,0 +1,118 @@
+package game
+
+import (
+	"slices"
+	"time"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+const MaxFriendship = 255
+
+// EvolutionContext is what is around an owned Pokémon when it tries to
+// evolve: the item used on it, if any, the time of day and its actual
+// stats.
+type EvolutionContext struct {
+	Item  string
+	Time  time.Time
+	Stats map[string]int
+}
+
+// TimeOfDay returns "day" from 6:00 to 18:00 and "night" otherwise. The
+// last hour of the day is also "dusk".
+func TimeOfDay(t time.Time) []string {
+	hour := t.Hour()
+	switch {
+	case hour == 17:
+		return []string{"day", "dusk"}
+	case hour >= 6 && hour < 18:
+		return []string{"day"}
+	}
+	return []string{"night"}
+}
+
+// CanEvolve reports whether o meets the evolution details d. Only level-up
+// and item evolutions are possible; details that depend on anything else,
+// such as trading, a held item or the party, are never met.
+func CanEvolve(o *Owned, d pokeapi.EvolutionDetail, ctx EvolutionContext) bool {
+	switch d.Trigger.Name {
+	case "level-up":
+		if ctx.Item != "" {
+			return false
+		}
+	case "use-item":
+		if d.Item == nil || d.Item.Name != ctx.Item {
+			return false
+		}
+	default:
+		return false
+	}
+
+	if d.HeldItem != nil || d.Location != nil || d.PartySpecies != nil || d.PartyType != nil ||
+		d.TradeSpecies != nil || d.Gender != nil || d.KnownMoveType != nil ||
+		d.MinBeauty != nil || d.MinAffection != nil || d.NeedsOverworldRain || d.TurnUpsideDown {
+		return false
+	}
+	if d.MinLevel != nil && o.Level < *d.MinLevel {
+		return false
+	}
+	if d.MinHappiness != nil && o.Friendship < *d.MinHappiness {
+		return false
+	}
+	if d.TimeOfDay != "" && !slices.Contains(TimeOfDay(ctx.Time), d.TimeOfDay) {
+		return false
+	}
+	if d.KnownMove != nil && !slices.Contains(o.Pokemon.LevelUpMoves(o.Level), d.KnownMove.Name) {
+		return false
+	}
+	if d.RelativePhysicalStats != nil {
+		attack, defense := ctx.Stats["attack"], ctx.Stats["defense"]
+		relative := 0
+		if attack > defense {
+			relative = 1
+		} else if attack < defense {
+			relative = -1
+		}
+		if relative != *d.RelativePhysicalStats {
+			return false
+		}
+	}
+	return true
+}
+
+// Evolutions returns the species link can evolve o into.
+func Evolutions(o *Owned, link pokeapi.ChainLink, ctx EvolutionContext) []pokeapi.ChainLink {
+	evolutions := []pokeapi.ChainLink{}
+	for _, next := range link.EvolvesTo {
+		for _, d := range next.EvolutionDetails {
+			if CanEvolve(o, d, ctx) {
+				evolutions = append(evolutions, next)
+				break
+			}
+		}
+	}
+	return evolutions
+}
+
+// Evolve turns o into evolved, keeping its ID, nickname, level, experience,
+// individual and effort values, nature, friendship and catch history.
+func (o *Owned) Evolve(evolved pokeapi.PokemonResponse) {
+	o.Pokemon = evolved
+}
+
+// RaiseFriendship adds n friendship, up to MaxFriendship.
+func (o *Owned) RaiseFriendship(n int) {
+	o.Friendship = min(o.Friendship+n, MaxFriendship)
+}
+
+// levelUpFriendship is how much friendship a level up gives, which shrinks
+// as the Pokémon grows friendlier.
+func levelUpFriendship(friendship int) int {
+	switch {
+	case friendship < 100:
+		return 5
+	case friendship < 200:
+		return 3
+	}
+	return 2
+}
@@ -0,0 +1,54 @@
+package game
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+func TestCanEvolve(t *testing.T) {
+	level16, happiness220, stronger := 16, 220, 1
+	levelUp := pokeapi.NamedResource{Name: "level-up"}
+	useItem := pokeapi.NamedResource{Name: "use-item"}
+	noon := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	midnight := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		owned    Owned
+		detail   pokeapi.EvolutionDetail
+		ctx      EvolutionContext
+		expected bool
+	}{
+		{Owned{Level: 16}, pokeapi.EvolutionDetail{Trigger: levelUp, MinLevel: &level16}, EvolutionContext{}, true},
+		{Owned{Level: 15}, pokeapi.EvolutionDetail{Trigger: levelUp, MinLevel: &level16}, EvolutionContext{}, false},
+		{Owned{Level: 16}, pokeapi.EvolutionDetail{Trigger: levelUp, MinLevel: &level16}, EvolutionContext{Item: "fire-stone"}, false},
+		{Owned{}, pokeapi.EvolutionDetail{Trigger: useItem, Item: &pokeapi.NamedResource{Name: "fire-stone"}}, EvolutionContext{Item: "fire-stone"}, true},
+		{Owned{}, pokeapi.EvolutionDetail{Trigger: useItem, Item: &pokeapi.NamedResource{Name: "fire-stone"}}, EvolutionContext{Item: "water-stone"}, false},
+		{Owned{Friendship: 220}, pokeapi.EvolutionDetail{Trigger: levelUp, MinHappiness: &happiness220, TimeOfDay: "day"}, EvolutionContext{Time: noon}, true},
+		{Owned{Friendship: 220}, pokeapi.EvolutionDetail{Trigger: levelUp, MinHappiness: &happiness220, TimeOfDay: "day"}, EvolutionContext{Time: midnight}, false},
+		{Owned{Friendship: 219}, pokeapi.EvolutionDetail{Trigger: levelUp, MinHappiness: &happiness220}, EvolutionContext{}, false},
+		{Owned{Level: 20}, pokeapi.EvolutionDetail{Trigger: levelUp, RelativePhysicalStats: &stronger}, EvolutionContext{Stats: map[string]int{"attack": 50, "defense": 40}}, true},
+		{Owned{Level: 20}, pokeapi.EvolutionDetail{Trigger: levelUp, RelativePhysicalStats: &stronger}, EvolutionContext{Stats: map[string]int{"attack": 40, "defense": 40}}, false},
+		{Owned{Level: 100}, pokeapi.EvolutionDetail{Trigger: pokeapi.NamedResource{Name: "trade"}}, EvolutionContext{}, false},
+		{Owned{Level: 100}, pokeapi.EvolutionDetail{Trigger: levelUp, HeldItem: &pokeapi.NamedResource{Name: "oval-stone"}}, EvolutionContext{}, false},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			if actual := CanEvolve(&c.owned, c.detail, c.ctx); actual != c.expected {
+				t.Errorf("expected %v, got %v", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFriendshipFromLevelUps(t *testing.T) {
+	o := &Owned{Level: 5, Friendship: 98}
+	o.GainExperience(20*20*20, testGrowthRate())
+	// 98 -> 103 at level 6, then 3 for each of the 14 levels up to 20.
+	if o.Level != 20 || o.Friendship != 145 {
+		t.Errorf("expected level 20 with 145 friendship, got level %d with %d", o.Level, o.Friendship)
+	}
+}
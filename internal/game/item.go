@@ -0,0 +1,29 @@
+package game
+
+// Items counts the evolution items a player is carrying, keyed by their
+// PokeAPI item name.
+type Items map[string]int
+
+// StartingItems is one of each of the classic evolution stones.
+func StartingItems() Items {
+	return Items{
+		"fire-stone":    1,
+		"water-stone":   1,
+		"thunder-stone": 1,
+		"leaf-stone":    1,
+		"moon-stone":    1,
+	}
+}
+
+// Use takes one item out of the bag, reporting false if there is none.
+func (items Items) Use(name string) bool {
+	if items[name] <= 0 {
+		return false
+	}
+	items[name]--
+	return true
+}
+
+func (items Items) Add(name string, n int) {
+	items[name] += n
+}
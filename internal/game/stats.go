@@ -0,0 +1,108 @@
+package game
+
+import (
+	"math/rand/v2"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+const MaxIV = 31
+const MaxEV = 252
+const MaxTotalEV = 510
+const MaxLevel = 100
+
+// StatNames are the six stats, named after their PokeAPI stat resources.
+var StatNames = []string{"hp", "attack", "defense", "special-attack", "special-defense", "speed"}
+
+// Natures lists the 25 natures, named after their PokeAPI nature resources.
+var Natures = []string{
+	"hardy", "lonely", "brave", "adamant", "naughty",
+	"bold", "docile", "relaxed", "impish", "lax",
+	"timid", "hasty", "serious", "jolly", "naive",
+	"modest", "mild", "quiet", "bashful", "rash",
+	"calm", "gentle", "sassy", "careful", "quirky",
+}
+
+// RollIVs gives every stat an individual value between 0 and MaxIV.
+func RollIVs(rng *rand.Rand) map[string]int {
+	ivs := map[string]int{}
+	for _, stat := range StatNames {
+		ivs[stat] = rng.IntN(MaxIV + 1)
+	}
+	return ivs
+}
+
+func RandomNature(rng *rand.Rand) string {
+	return Natures[rng.IntN(len(Natures))]
+}
+
+// CalcStat computes a stat at level from its base stat, individual and
+// effort values and nature modifier, using the generation III onwards
+// formula.
+func CalcStat(stat string, base, iv, ev, level int, nature float64) int {
+	value := (2*base + iv + ev/4) * level / 100
+	if stat == "hp" {
+		return value + level + 10
+	}
+	return int(float64(value+5) * nature)
+}
+
+// Stats computes all of a Pokémon's stats at level. Missing individual or
+// effort values count as 0 and a zero nature is neutral.
+func Stats(p pokeapi.PokemonResponse, level int, ivs, evs map[string]int, nature pokeapi.NatureResponse) map[string]int {
+	stats := map[string]int{}
+	for _, stat := range StatNames {
+		stats[stat] = CalcStat(stat, p.BaseStat(stat), ivs[stat], evs[stat], level, nature.Modifier(stat))
+	}
+	return stats
+}
+
+// ExperienceYield is the experience gained for defeating a wild Pokémon,
+// from its base experience and level.
+func ExperienceYield(baseExperience, level int) int {
+	return max(1, baseExperience*level/7)
+}
+
+func (o *Owned) Stats(nature pokeapi.NatureResponse) map[string]int {
+	return Stats(o.Pokemon, o.Level, o.IVs, o.EVs, nature)
+}
+
+// SyncExperience raises the Pokémon's experience to the minimum for its
+// level, for Pokémon caught before experience was tracked.
+func (o *Owned) SyncExperience(growth pokeapi.GrowthRateResponse) {
+	o.Experience = max(o.Experience, growth.Experience(o.Level))
+}
+
+// GainExperience adds exp and levels the Pokémon up along its growth rate
+// curve, returning how many levels it gained. Each level gained also raises
+// its friendship.
+func (o *Owned) GainExperience(exp int, growth pokeapi.GrowthRateResponse) int {
+	o.SyncExperience(growth)
+	o.Experience = min(o.Experience+exp, growth.Experience(MaxLevel))
+	level := max(o.Level, min(growth.Level(o.Experience), MaxLevel))
+	gained := level - o.Level
+	for range gained {
+		o.RaiseFriendship(levelUpFriendship(o.Friendship))
+	}
+	o.Level = level
+	return gained
+}
+
+// GainEffort adds the effort values defeated yields, keeping each stat
+// under MaxEV and the total under MaxTotalEV.
+func (o *Owned) GainEffort(defeated pokeapi.PokemonResponse) {
+	if o.EVs == nil {
+		o.EVs = map[string]int{}
+	}
+	total := 0
+	for _, ev := range o.EVs {
+		total += ev
+	}
+	for _, s := range defeated.Stats {
+		gain := min(s.Effort, MaxEV-o.EVs[s.Stat.Name], MaxTotalEV-total)
+		if gain > 0 {
+			o.EVs[s.Stat.Name] += gain
+			total += gain
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package game
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+func TestCalcStat(t *testing.T) {
+	cases := []struct {
+		stat                string
+		base, iv, ev, level int
+		nature              float64
+		expected            int
+	}{
+		{"hp", 100, 31, 252, 100, 1, 404},
+		{"attack", 100, 31, 252, 100, 1, 299},
+		{"attack", 100, 31, 252, 100, 1.1, 328},
+		{"attack", 100, 31, 252, 100, 0.9, 269},
+		{"hp", 100, 31, 252, 50, 1, 207},
+		{"speed", 100, 31, 252, 50, 1.1, 167},
+		{"hp", 35, 0, 0, 50, 1, 95},
+		{"hp", 35, 0, 0, 50, 1, MaxHP(35, 50)},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			if actual := CalcStat(c.stat, c.base, c.iv, c.ev, c.level, c.nature); actual != c.expected {
+				t.Errorf("expected %s %d, got %d", c.stat, c.expected, actual)
+			}
+		})
+	}
+}
+
+func testGrowthRate() pokeapi.GrowthRateResponse {
+	growth := pokeapi.GrowthRateResponse{Name: "medium"}
+	for level := 1; level <= MaxLevel; level++ {
+		growth.Levels = append(growth.Levels, struct {
+			Level      int `json:"level"`
+			Experience int `json:"experience"`
+		}{level, level * level * level})
+	}
+	return growth
+}
+
+func TestGainExperience(t *testing.T) {
+	growth := testGrowthRate()
+	o := &Owned{Level: 5}
+
+	if gained := o.GainExperience(10, growth); gained != 0 || o.Experience != 135 {
+		t.Errorf("expected to stay at level 5 with 135 EXP, gained %d with %d EXP", gained, o.Experience)
+	}
+	if gained := o.GainExperience(400, growth); gained != 3 || o.Level != 8 {
+		t.Errorf("expected to grow to level 8, gained %d to level %d", gained, o.Level)
+	}
+	o.GainExperience(10_000_000, growth)
+	if o.Level != MaxLevel || o.Experience != growth.Experience(MaxLevel) {
+		t.Errorf("expected to stop at level %d, got level %d with %d EXP", MaxLevel, o.Level, o.Experience)
+	}
+}
+
+func TestGainEffort(t *testing.T) {
+	defeated := pokeapi.PokemonResponse{}
+	defeated.Stats = append(defeated.Stats, struct {
+		BaseStat int `json:"base_stat"`
+		Effort   int `json:"effort"`
+		Stat     struct {
+			Name string `json:"name"`
+			URL  string `json:"url"`
+		} `json:"stat"`
+	}{Effort: 3})
+	defeated.Stats[0].Stat.Name = "speed"
+
+	o := &Owned{EVs: map[string]int{"attack": MaxEV, "hp": 250}}
+	o.GainEffort(defeated)
+	if o.EVs["speed"] != 3 {
+		t.Errorf("expected 3 speed EVs, got %d", o.EVs["speed"])
+	}
+	o.GainEffort(defeated)
+	if o.EVs["speed"] != 6 {
+		t.Errorf("expected 6 speed EVs, got %d", o.EVs["speed"])
+	}
+	o.GainEffort(defeated)
+	if o.EVs["speed"] != 8 {
+		t.Errorf("expected the total to stop at %d, got %d speed EVs", MaxTotalEV, o.EVs["speed"])
+	}
+}
@@ -0,0 +1,261 @@
+package game
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+const PartySize = 6
+const BoxCount = 8
+const BoxSize = 30
+
+var (
+	ErrPartyFull   = errors.New("game: party is full")
+	ErrBoxFull     = errors.New("game: box is full")
+	ErrStorageFull = errors.New("game: party and boxes are full")
+	ErrNoBox       = errors.New("game: no such box")
+	ErrNotOwned    = errors.New("game: pokemon is not owned")
+	ErrLastPokemon = errors.New("game: cannot leave the party empty")
+	ErrInParty     = errors.New("game: pokemon is already in the party")
+	ErrInBox       = errors.New("game: pokemon is already in a box")
+)
+
+// Owned is a Pokémon caught by the player. Pokemon holds its species data.
+type Owned struct {
+	ID         int                     `json:"id"`
+	Nickname   string                  `json:"nickname,omitempty"`
+	Level      int                     `json:"level"`
+	Experience int                     `json:"experience"`
+	Nature     string                  `json:"nature"`
+	IVs        map[string]int          `json:"ivs"`
+	EVs        map[string]int          `json:"evs"`
+	Friendship int                     `json:"friendship"`
+	CaughtAt   time.Time               `json:"caught_at"`
+	Location   string                  `json:"location,omitempty"`
+	Ball       Ball                    `json:"ball,omitempty"`
+	Pokemon    pokeapi.PokemonResponse `json:"pokemon"`
+}
+
+// Name is the Pokémon's nickname, or its species name when it has none.
+func (o *Owned) Name() string {
+	if o.Nickname != "" {
+		return o.Nickname
+	}
+	return o.Pokemon.Name
+}
+
+// Storage holds the player's party and PC boxes. Boxes are numbered from 1
+// and only allocated once something is put in them.
+type Storage struct {
+	Party  []*Owned   `json:"party"`
+	Boxes  [][]*Owned `json:"boxes"`
+	LastID int        `json:"last_id"`
+}
+
+func NewStorage() *Storage {
+	return &Storage{Party: []*Owned{}, Boxes: [][]*Owned{}}
+}
+
+// Box returns the Pokémon in box n.
+func (s *Storage) Box(n int) []*Owned {
+	if n < 1 || n > len(s.Boxes) {
+		return nil
+	}
+	return s.Boxes[n-1]
+}
+
+func (s *Storage) setBox(n int, box []*Owned) {
+	for len(s.Boxes) < n {
+		s.Boxes = append(s.Boxes, []*Owned{})
+	}
+	s.Boxes[n-1] = box
+}
+
+// freeBox returns the first box with room, or 0 when all are full.
+func (s *Storage) freeBox() int {
+	for n := 1; n <= BoxCount; n++ {
+		if len(s.Box(n)) < BoxSize {
+			return n
+		}
+	}
+	return 0
+}
+
+// All returns every owned Pokémon, party first.
+func (s *Storage) All() []*Owned {
+	all := slices.Clone(s.Party)
+	for _, box := range s.Boxes {
+		all = append(all, box...)
+	}
+	return all
+}
+
+func (s *Storage) Len() int {
+	return len(s.All())
+}
+
+// Add gives o a new ID and puts it in the party, or in the first box with
+// room when the party is full. It returns the box it went to, 0 for the
+// party.
+func (s *Storage) Add(o Owned) (*Owned, int, error) {
+	box := 0
+	if len(s.Party) >= PartySize {
+		if box = s.freeBox(); box == 0 {
+			return nil, 0, ErrStorageFull
+		}
+	}
+	s.LastID++
+	o.ID = s.LastID
+	owned := &o
+	if box == 0 {
+		s.Party = append(s.Party, owned)
+	} else {
+		s.setBox(box, append(s.Box(box), owned))
+	}
+	return owned, box, nil
+}
+
+// Find returns the Pokémon matching ref, which is an ID (optionally
+// prefixed with '#'), a nickname or a species name, ignoring case.
+func (s *Storage) Find(ref string) []*Owned {
+	if id, err := strconv.Atoi(strings.TrimPrefix(ref, "#")); err == nil {
+		if o, _, _ := s.locate(id); o != nil {
+			return []*Owned{o}
+		}
+		return nil
+	}
+
+	matches := []*Owned{}
+	for _, o := range s.All() {
+		if strings.EqualFold(o.Nickname, ref) {
+			matches = append(matches, o)
+		}
+	}
+	if len(matches) > 0 {
+		return matches
+	}
+	for _, o := range s.All() {
+		if strings.EqualFold(o.Pokemon.Name, ref) {
+			matches = append(matches, o)
+		}
+	}
+	return matches
+}
+
+// Locate returns the box holding the Pokémon with id, 0 for the party.
+func (s *Storage) Locate(id int) (int, bool) {
+	o, box, _ := s.locate(id)
+	return box, o != nil
+}
+
+func (s *Storage) locate(id int) (*Owned, int, int) {
+	for i, o := range s.Party {
+		if o.ID == id {
+			return o, 0, i
+		}
+	}
+	for b, box := range s.Boxes {
+		for i, o := range box {
+			if o.ID == id {
+				return o, b + 1, i
+			}
+		}
+	}
+	return nil, 0, 0
+}
+
+func (s *Storage) remove(id int) (*Owned, int, error) {
+	o, box, i := s.locate(id)
+	if o == nil {
+		return nil, 0, ErrNotOwned
+	}
+	if box == 0 {
+		s.Party = slices.Delete(s.Party, i, i+1)
+	} else {
+		s.setBox(box, slices.Delete(s.Box(box), i, i+1))
+	}
+	return o, box, nil
+}
+
+// Deposit moves a party Pokémon into box n, or the first box with room when
+// n is 0. It returns the box used.
+func (s *Storage) Deposit(id, n int) (int, error) {
+	box, ok := s.Locate(id)
+	switch {
+	case !ok:
+		return 0, ErrNotOwned
+	case box != 0:
+		return 0, ErrInBox
+	case len(s.Party) == 1:
+		return 0, ErrLastPokemon
+	}
+	if n == 0 {
+		if n = s.freeBox(); n == 0 {
+			return 0, ErrBoxFull
+		}
+	}
+	if n < 1 || n > BoxCount {
+		return 0, ErrNoBox
+	}
+	if len(s.Box(n)) >= BoxSize {
+		return 0, ErrBoxFull
+	}
+
+	o, _, _ := s.remove(id)
+	s.setBox(n, append(s.Box(n), o))
+	return n, nil
+}
+
+// Withdraw moves a boxed Pokémon into the party.
+func (s *Storage) Withdraw(id int) error {
+	box, ok := s.Locate(id)
+	switch {
+	case !ok:
+		return ErrNotOwned
+	case box == 0:
+		return ErrInParty
+	case len(s.Party) >= PartySize:
+		return ErrPartyFull
+	}
+
+	o, _, _ := s.remove(id)
+	s.Party = append(s.Party, o)
+	return nil
+}
+
+// Swap exchanges the places of two Pokémon, in the party or in boxes.
+func (s *Storage) Swap(a, b int) error {
+	oa, boxA, i := s.locate(a)
+	ob, boxB, j := s.locate(b)
+	if oa == nil || ob == nil {
+		return ErrNotOwned
+	}
+	s.slot(boxA)[i], s.slot(boxB)[j] = ob, oa
+	return nil
+}
+
+func (s *Storage) slot(box int) []*Owned {
+	if box == 0 {
+		return s.Party
+	}
+	return s.Box(box)
+}
+
+// Release lets a Pokémon go for good. The last Pokémon in the party can't
+// be released.
+func (s *Storage) Release(id int) error {
+	box, ok := s.Locate(id)
+	if !ok {
+		return ErrNotOwned
+	}
+	if box == 0 && len(s.Party) == 1 {
+		return ErrLastPokemon
+	}
+	_, _, err := s.remove(id)
+	return err
+}
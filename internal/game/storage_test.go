@@ -0,0 +1,103 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+func testStorage(names ...string) *Storage {
+	s := NewStorage()
+	for _, name := range names {
+		s.Add(Owned{Level: 5, Pokemon: pokeapi.PokemonResponse{Name: name}})
+	}
+	return s
+}
+
+func TestStorageAdd(t *testing.T) {
+	s := testStorage("pidgey", "pidgey", "rattata", "zubat", "geodude", "onix")
+
+	owned, box, err := s.Add(Owned{Pokemon: pokeapi.PokemonResponse{Name: "pidgey"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if owned.ID != 7 || box != 1 {
+		t.Errorf("expected #7 to go to box 1, got #%d in box %d", owned.ID, box)
+	}
+	if len(s.Party) != PartySize || len(s.Box(1)) != 1 {
+		t.Errorf("unexpected storage: %d in party, %d in box 1", len(s.Party), len(s.Box(1)))
+	}
+	if len(s.Find("pidgey")) != 3 {
+		t.Errorf("expected three pidgeys, got %d", len(s.Find("pidgey")))
+	}
+}
+
+func TestStorageFind(t *testing.T) {
+	s := testStorage("pidgey", "pidgey", "rattata")
+	s.Party[1].Nickname = "Rattata"
+
+	cases := []struct {
+		ref      string
+		expected []int
+	}{
+		{"#1", []int{1}},
+		{"3", []int{3}},
+		{"9", nil},
+		{"pidgey", []int{1, 2}},
+		{"PIDGEY", []int{1, 2}},
+		{"rattata", []int{2}},
+		{"zubat", nil},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			matches := s.Find(c.ref)
+			if len(matches) != len(c.expected) {
+				t.Fatalf("expected %v, got %d matches", c.expected, len(matches))
+			}
+			for j, m := range matches {
+				if m.ID != c.expected[j] {
+					t.Errorf("expected %v, got #%d at %d", c.expected, m.ID, j)
+				}
+			}
+		})
+	}
+}
+
+func TestStorageMoves(t *testing.T) {
+	s := testStorage("pidgey", "rattata")
+
+	if box, err := s.Deposit(1, 0); err != nil || box != 1 {
+		t.Fatalf("expected deposit into box 1, got %d, %v", box, err)
+	}
+	if _, err := s.Deposit(2, 0); !errors.Is(err, ErrLastPokemon) {
+		t.Errorf("expected ErrLastPokemon, got %v", err)
+	}
+	if err := s.Release(2); !errors.Is(err, ErrLastPokemon) {
+		t.Errorf("expected ErrLastPokemon, got %v", err)
+	}
+	if _, err := s.Deposit(1, 2); !errors.Is(err, ErrInBox) {
+		t.Errorf("expected ErrInBox, got %v", err)
+	}
+
+	if err := s.Swap(1, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s.Party[0].ID != 1 || s.Box(1)[0].ID != 2 {
+		t.Errorf("expected pidgey and rattata to change places")
+	}
+
+	if err := s.Withdraw(2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := s.Release(1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s.Len() != 1 || s.Party[0].ID != 2 {
+		t.Errorf("expected only rattata to be left in the party")
+	}
+	if err := s.Release(1); !errors.Is(err, ErrNotOwned) {
+		t.Errorf("expected ErrNotOwned, got %v", err)
+	}
+}
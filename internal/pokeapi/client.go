@@ -0,0 +1,211 @@
+package pokeapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokecache"
+)
+
+const DefaultBaseURL = "https://pokeapi.co/api/v2"
+const DefaultUserAgent = "pokedex-go"
+
+const DefaultRequestsPerSecond = 5
+const DefaultBurst = 10
+
+// Client talks to a PokeAPI compatible server. BaseURL can point at the
+// public API, a self-hosted mirror or an httptest server. A nil Limiter
+// disables client-side rate limiting.
+type Client struct {
+	BaseURL    string
+	HTTPClient *http.Client
+	Cache      *pokecache.Cache
+	UserAgent  string
+	Retry      RetryPolicy
+	Limiter    *RateLimiter
+
+	stats   counters
+	flights flightGroup
+
+	typeChart   TypeChart
+	typeChartMu sync.Mutex
+}
+
+func NewClient(baseURL string) *Client {
+	return NewClientWithCache(baseURL, pokecache.NewCache(5*time.Second))
+}
+
+// NewClientWithCache creates a client using cache, which it closes on Close.
+func NewClientWithCache(baseURL string, cache *pokecache.Cache) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &Client{
+		BaseURL:    strings.TrimRight(baseURL, "/"),
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+		Cache:      cache,
+		UserAgent:  DefaultUserAgent,
+		Retry:      DefaultRetryPolicy,
+		Limiter:    NewRateLimiter(DefaultRequestsPerSecond, DefaultBurst),
+	}
+}
+
+// Close releases the client's cache.
+func (c *Client) Close() error {
+	if c.Cache == nil {
+		return nil
+	}
+	return c.Cache.Close()
+}
+
+// NewConfig returns a Config positioned before the first page of location areas.
+func (c *Client) NewConfig() *Config {
+	return &Config{
+		Next:     c.endpoint("location-area") + "?limit=20&offset=0",
+		Previous: "",
+	}
+}
+
+func (c *Client) endpoint(parts ...string) string {
+	path := strings.TrimRight(c.BaseURL, "/")
+	for _, p := range parts {
+		path += "/" + url.PathEscape(p)
+	}
+	return path
+}
+
+// resolve turns links that are relative to the server, such as
+// "/api/v2/pokemon/1/encounters", into absolute URLs.
+func (c *Client) resolve(link string) string {
+	if link == "" {
+		return ""
+	}
+	ref, err := url.Parse(link)
+	if err != nil || ref.IsAbs() {
+		return link
+	}
+	base, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return link
+	}
+	return base.ResolveReference(ref).String()
+}
+
+func (c *Client) fetch(ctx context.Context, rawURL string) ([]byte, error) {
+	if u, err := url.Parse(rawURL); err != nil || u.Host == "" {
+		return nil, fmt.Errorf("pokeapi: invalid URL %q", rawURL)
+	}
+	attempts := max(1, c.Retry.MaxAttempts)
+
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		var data []byte
+		var retryAfter time.Duration
+		data, retryAfter, err = c.fetchOnce(ctx, rawURL)
+		if err == nil {
+			return data, nil
+		}
+		if attempt == attempts || !retryable(err) {
+			break
+		}
+		if c.Retry.MaxDelay > 0 && retryAfter > c.Retry.MaxDelay {
+			// Waiting that long would stall the command, so give up.
+			break
+		}
+
+		delay := max(c.Retry.backoff(attempt), retryAfter)
+		if err := sleep(ctx, delay); err != nil {
+			c.stats.failures.Add(1)
+			return nil, err
+		}
+		c.stats.retries.Add(1)
+	}
+
+	c.stats.failures.Add(1)
+	return nil, err
+}
+
+func (c *Client) fetchOnce(ctx context.Context, url string) ([]byte, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	if c.Limiter != nil {
+		waited, err := c.Limiter.Wait(ctx)
+		if waited {
+			c.stats.rateLimited.Add(1)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	c.stats.requests.Add(1)
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		io.Copy(io.Discard, res.Body)
+		if res.StatusCode == http.StatusTooManyRequests {
+			c.stats.throttled.Add(1)
+		}
+		retryAfter := parseRetryAfter(res.Header.Get("Retry-After"), time.Now())
+		return nil, retryAfter, &HTTPError{StatusCode: res.StatusCode, URL: url}
+	}
+
+	data, err := io.ReadAll(res.Body)
+	return data, 0, err
+}
+
+// fetchCached returns the body for url from the cache, or fetches it and
+// stores it in the cache. Concurrent fetches of the same url share one request.
+func (c *Client) fetchCached(ctx context.Context, url string) ([]byte, error) {
+	if c.Cache != nil {
+		if data, ok := c.Cache.Get(url); ok {
+			return data, nil
+		}
+	}
+
+	return c.flights.do(ctx, url, func() ([]byte, error) {
+		data, err := c.fetch(ctx, url)
+		if err != nil {
+			return nil, err
+		}
+		if c.Cache != nil && json.Valid(data) {
+			c.Cache.Add(url, data)
+		}
+		return data, nil
+	})
+}
+
+func getData[T any](ctx context.Context, c *Client, url string) (T, error) {
+	var res T
+	data, err := c.fetchCached(ctx, url)
+	if err != nil {
+		return res, err
+	}
+
+	res, err = decodeJson[T](data)
+	if err != nil {
+		return res, &DecodeError{URL: url, Err: err}
+	}
+	return res, nil
+}
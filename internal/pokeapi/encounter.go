@@ -0,0 +1,134 @@
+package pokeapi
+
+import (
+	"context"
+	"slices"
+	"strings"
+)
+
+// Encounter is one way a Pokémon can be met in a location area: a game
+// version, an encounter method and the level range and chance for it.
+// MethodRate is the area's rate for the method in that version, e.g. the
+// percentage of steps in tall grass that trigger an encounter. It is only
+// known for encounters read from a location area. Conditions lists the
+// sorted condition values, such as "time-night" or "swarm-yes", the slot
+// only applies under; it is empty for slots that always apply.
+type Encounter struct {
+	Area       string
+	Pokemon    string
+	Version    string
+	Method     string
+	Chance     int
+	MinLevel   int
+	MaxLevel   int
+	MethodRate int
+	Conditions []string
+}
+
+// Condition describes the encounter's conditions, e.g. "swarm-yes,
+// time-day", or returns an empty string when it has none.
+func (e Encounter) Condition() string {
+	return strings.Join(e.Conditions, ", ")
+}
+
+func conditionNames(values []NamedResource) []string {
+	names := []string{}
+	for _, v := range values {
+		names = append(names, v.Name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func (c *Client) GetLocationArea(ctx context.Context, area string) (AreaResponse, error) {
+	url := c.endpoint("location-area", area)
+	return getData[AreaResponse](ctx, c, url)
+}
+
+// Encounters flattens the area's nested encounter details into one entry
+// per Pokémon, version and encounter slot.
+func (a AreaResponse) Encounters() []Encounter {
+	rates := map[[2]string]int{}
+	for _, mr := range a.EncounterMethodRates {
+		for _, vd := range mr.VersionDetails {
+			rates[[2]string{mr.EncounterMethod.Name, vd.Version.Name}] = vd.Rate
+		}
+	}
+
+	encounters := []Encounter{}
+	for _, pe := range a.PokemonEncounters {
+		for _, vd := range pe.VersionDetails {
+			for _, d := range vd.EncounterDetails {
+				encounters = append(encounters, Encounter{
+					Area:       a.Name,
+					Pokemon:    pe.Pokemon.Name,
+					Version:    vd.Version.Name,
+					Method:     d.Method.Name,
+					Chance:     d.Chance,
+					MinLevel:   d.MinLevel,
+					MaxLevel:   d.MaxLevel,
+					MethodRate: rates[[2]string{d.Method.Name, vd.Version.Name}],
+					Conditions: conditionNames(d.ConditionValues),
+				})
+			}
+		}
+	}
+	return encounters
+}
+
+type PokemonEncountersResponse []struct {
+	LocationArea struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"location_area"`
+	VersionDetails []struct {
+		EncounterDetails []struct {
+			Chance          int             `json:"chance"`
+			ConditionValues []NamedResource `json:"condition_values"`
+			MaxLevel        int             `json:"max_level"`
+			Method          struct {
+				Name string `json:"name"`
+				URL  string `json:"url"`
+			} `json:"method"`
+			MinLevel int `json:"min_level"`
+		} `json:"encounter_details"`
+		MaxChance int `json:"max_chance"`
+		Version   struct {
+			Name string `json:"name"`
+			URL  string `json:"url"`
+		} `json:"version"`
+	} `json:"version_details"`
+}
+
+// GetPokemonEncounters lists every location area, version and method where
+// the Pokémon can be met in the wild, following its
+// location_area_encounters link.
+func (c *Client) GetPokemonEncounters(ctx context.Context, pokemon PokemonResponse) ([]Encounter, error) {
+	url := c.resolve(pokemon.LocationAreaEncounters)
+	if url == "" {
+		url = c.endpoint("pokemon", pokemon.Name, "encounters")
+	}
+	res, err := getData[PokemonEncountersResponse](ctx, c, url)
+	if err != nil {
+		return nil, err
+	}
+
+	encounters := []Encounter{}
+	for _, la := range res {
+		for _, vd := range la.VersionDetails {
+			for _, d := range vd.EncounterDetails {
+				encounters = append(encounters, Encounter{
+					Area:       la.LocationArea.Name,
+					Pokemon:    pokemon.Name,
+					Version:    vd.Version.Name,
+					Method:     d.Method.Name,
+					Chance:     d.Chance,
+					MinLevel:   d.MinLevel,
+					MaxLevel:   d.MaxLevel,
+					Conditions: conditionNames(d.ConditionValues),
+				})
+			}
+		}
+	}
+	return encounters, nil
+}
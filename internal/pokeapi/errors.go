@@ -0,0 +1,38 @@
+package pokeapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var ErrNotFound = errors.New("pokeapi: resource not found")
+
+// HTTPError is returned when the server answers with a non-2xx status code.
+// A 404 response matches ErrNotFound with errors.Is.
+type HTTPError struct {
+	StatusCode int
+	URL        string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("pokeapi: GET %s: %d %s", e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+func (e *HTTPError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+// DecodeError is returned when a response body is not the JSON we expected.
+type DecodeError struct {
+	URL string
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("pokeapi: decoding %s: %v", e.URL, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
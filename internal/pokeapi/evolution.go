@@ -0,0 +1,70 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+)
+
+type NamedResource struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+type EvolutionChainResponse struct {
+	ID              int            `json:"id"`
+	BabyTriggerItem *NamedResource `json:"baby_trigger_item"`
+	Chain           ChainLink      `json:"chain"`
+}
+
+// ChainLink is one species in an evolution chain together with the species
+// it evolves into. EvolutionDetails describes how the species is reached
+// from its parent link and is empty for the base of the chain.
+type ChainLink struct {
+	IsBaby           bool              `json:"is_baby"`
+	Species          NamedResource     `json:"species"`
+	EvolutionDetails []EvolutionDetail `json:"evolution_details"`
+	EvolvesTo        []ChainLink       `json:"evolves_to"`
+}
+
+type EvolutionDetail struct {
+	Trigger               NamedResource  `json:"trigger"`
+	Item                  *NamedResource `json:"item"`
+	HeldItem              *NamedResource `json:"held_item"`
+	KnownMove             *NamedResource `json:"known_move"`
+	KnownMoveType         *NamedResource `json:"known_move_type"`
+	Location              *NamedResource `json:"location"`
+	PartySpecies          *NamedResource `json:"party_species"`
+	PartyType             *NamedResource `json:"party_type"`
+	TradeSpecies          *NamedResource `json:"trade_species"`
+	Gender                *int           `json:"gender"`
+	MinLevel              *int           `json:"min_level"`
+	MinHappiness          *int           `json:"min_happiness"`
+	MinBeauty             *int           `json:"min_beauty"`
+	MinAffection          *int           `json:"min_affection"`
+	RelativePhysicalStats *int           `json:"relative_physical_stats"`
+	NeedsOverworldRain    bool           `json:"needs_overworld_rain"`
+	TurnUpsideDown        bool           `json:"turn_upside_down"`
+	TimeOfDay             string         `json:"time_of_day"`
+}
+
+var ErrNoEvolutionChain = errors.New("pokeapi: species has no evolution chain")
+
+func (c *Client) GetEvolutionChain(ctx context.Context, species SpeciesResponse) (EvolutionChainResponse, error) {
+	if species.EvolutionChain.URL == "" {
+		return EvolutionChainResponse{}, ErrNoEvolutionChain
+	}
+	return getData[EvolutionChainResponse](ctx, c, c.resolve(species.EvolutionChain.URL))
+}
+
+// Find returns the link for species within the chain.
+func (l ChainLink) Find(species string) (ChainLink, bool) {
+	if l.Species.Name == species {
+		return l, true
+	}
+	for _, next := range l.EvolvesTo {
+		if found, ok := next.Find(species); ok {
+			return found, true
+		}
+	}
+	return ChainLink{}, false
+}
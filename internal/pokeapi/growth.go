@@ -0,0 +1,67 @@
+package pokeapi
+
+import "context"
+
+type GrowthRateResponse struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Formula string `json:"formula"`
+	Levels  []struct {
+		Level      int `json:"level"`
+		Experience int `json:"experience"`
+	} `json:"levels"`
+}
+
+func (c *Client) GetGrowthRate(ctx context.Context, name string) (GrowthRateResponse, error) {
+	url := c.endpoint("growth-rate", name)
+	return getData[GrowthRateResponse](ctx, c, url)
+}
+
+// Experience returns the total experience needed to reach level.
+func (g GrowthRateResponse) Experience(level int) int {
+	exp := 0
+	for _, l := range g.Levels {
+		if l.Level <= level && l.Experience > exp {
+			exp = l.Experience
+		}
+	}
+	return exp
+}
+
+// Level returns the level reached with exp total experience.
+func (g GrowthRateResponse) Level(exp int) int {
+	level := 1
+	for _, l := range g.Levels {
+		if l.Experience <= exp && l.Level > level {
+			level = l.Level
+		}
+	}
+	return level
+}
+
+type NatureResponse struct {
+	ID            int            `json:"id"`
+	Name          string         `json:"name"`
+	IncreasedStat *NamedResource `json:"increased_stat"`
+	DecreasedStat *NamedResource `json:"decreased_stat"`
+}
+
+func (c *Client) GetNature(ctx context.Context, name string) (NatureResponse, error) {
+	url := c.endpoint("nature", name)
+	return getData[NatureResponse](ctx, c, url)
+}
+
+// Modifier is the nature's multiplier for stat: 1.1 for the stat it
+// raises, 0.9 for the one it lowers and 1 otherwise. Natures that raise
+// and lower the same stat are neutral.
+func (n NatureResponse) Modifier(stat string) float64 {
+	up := n.IncreasedStat != nil && n.IncreasedStat.Name == stat
+	down := n.DecreasedStat != nil && n.DecreasedStat.Name == stat
+	switch {
+	case up && !down:
+		return 1.1
+	case down && !up:
+		return 0.9
+	}
+	return 1
+}
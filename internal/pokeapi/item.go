@@ -0,0 +1,58 @@
+package pokeapi
+
+import "context"
+
+type ItemResponse struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Cost     int    `json:"cost"`
+	Category struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"category"`
+	EffectEntries []struct {
+		Effect      string `json:"effect"`
+		ShortEffect string `json:"short_effect"`
+		Language    struct {
+			Name string `json:"name"`
+			URL  string `json:"url"`
+		} `json:"language"`
+	} `json:"effect_entries"`
+	Names []struct {
+		Name     string `json:"name"`
+		Language struct {
+			Name string `json:"name"`
+			URL  string `json:"url"`
+		} `json:"language"`
+	} `json:"names"`
+}
+
+func (c *Client) GetItem(ctx context.Context, item string) (ItemResponse, error) {
+	url := c.endpoint("item", item)
+	return getData[ItemResponse](ctx, c, url)
+}
+
+func (c *Client) GetAllItemNames(ctx context.Context) ([]string, error) {
+	return c.getAllNames(ctx, "item")
+}
+
+// DisplayName returns the item's name in the given language, falling back
+// to its resource name.
+func (i ItemResponse) DisplayName(language string) string {
+	for _, n := range i.Names {
+		if n.Language.Name == language {
+			return n.Name
+		}
+	}
+	return i.Name
+}
+
+// ShortEffect returns the item's short effect text in the given language.
+func (i ItemResponse) ShortEffect(language string) string {
+	for _, e := range i.EffectEntries {
+		if e.Language.Name == language {
+			return e.ShortEffect
+		}
+	}
+	return ""
+}
@@ -0,0 +1,52 @@
+package pokeapi
+
+import (
+	"context"
+	"slices"
+	"strings"
+)
+
+type MoveResponse struct {
+	ID          int           `json:"id"`
+	Name        string        `json:"name"`
+	Accuracy    *int          `json:"accuracy"`
+	Power       *int          `json:"power"`
+	PP          int           `json:"pp"`
+	Priority    int           `json:"priority"`
+	Type        NamedResource `json:"type"`
+	DamageClass NamedResource `json:"damage_class"`
+}
+
+func (c *Client) GetMove(ctx context.Context, name string) (MoveResponse, error) {
+	url := c.endpoint("move", name)
+	return getData[MoveResponse](ctx, c, url)
+}
+
+// LevelUpMoves returns the moves a Pokémon learns by levelling up to level,
+// ordered by the level they are learned at. A move learned at different
+// levels in different games counts at the earliest one.
+func (p PokemonResponse) LevelUpMoves(level int) []string {
+	learnedAt := map[string]int{}
+	for _, m := range p.Moves {
+		for _, d := range m.VersionGroupDetails {
+			if d.MoveLearnMethod.Name != "level-up" || d.LevelLearnedAt > level {
+				continue
+			}
+			if l, ok := learnedAt[m.Move.Name]; !ok || d.LevelLearnedAt < l {
+				learnedAt[m.Move.Name] = d.LevelLearnedAt
+			}
+		}
+	}
+
+	names := []string{}
+	for name := range learnedAt {
+		names = append(names, name)
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		if learnedAt[a] != learnedAt[b] {
+			return learnedAt[a] - learnedAt[b]
+		}
+		return strings.Compare(a, b)
+	})
+	return names
+}
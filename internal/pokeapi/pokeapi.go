@@ -1,16 +1,10 @@
 package pokeapi
 
 import (
+	"context"
 	"encoding/json"
-	"io"
-	"net/http"
-	"time"
-
-	"github.com/kartikey-tiwari/pokedex-go/internal/pokecache"
 )
 
-var cache *pokecache.Cache = pokecache.NewCache(5 * time.Second)
-
 type LocationResponse struct {
 	Count    int    `json:"count"`
 	Next     string `json:"next"`
@@ -56,9 +50,9 @@ type AreaResponse struct {
 		} `json:"pokemon"`
 		VersionDetails []struct {
 			EncounterDetails []struct {
-				Chance          int   `json:"chance"`
-				ConditionValues []any `json:"condition_values"`
-				MaxLevel        int   `json:"max_level"`
+				Chance          int             `json:"chance"`
+				ConditionValues []NamedResource `json:"condition_values"`
+				MaxLevel        int             `json:"max_level"`
 				Method          struct {
 					Name string `json:"name"`
 					URL  string `json:"url"`
@@ -358,22 +352,6 @@ func decodeJson[T any](data []byte) (T, error) {
 	return res, nil
 }
 
-func fetchLocationData[T any](url string) (T, error) {
-	var fetchedData T
-	res, err := http.Get(url)
-	if err != nil {
-		return fetchedData, err
-	}
-	defer res.Body.Close()
-
-	data, err := io.ReadAll(res.Body)
-	if err != nil {
-		return fetchedData, err
-	}
-
-	return decodeJson[T](data)
-}
-
 func getLocationsFromResponse(locationRes LocationResponse) []string {
 	locations := []string{}
 	if locationRes.Results == nil {
@@ -396,66 +374,70 @@ func setConfig(c *Config, locationRes LocationResponse) {
 	}
 }
 
-func getData[T any](url string) (T, error) {
-	var res T
-	var err error
-	data, ok := cache.Get(url)
-	if ok {
-		res, err = decodeJson[T](data)
-		if err != nil {
-			return res, err
-		}
-	} else {
-
-		res, err = fetchLocationData[T](url)
-		if err != nil {
-			return res, err
-		}
-	}
-	return res, nil
-}
-
-func GetLocationAreaNames(c *Config, next bool) ([]string, error) {
+func (c *Client) GetLocationAreaNames(ctx context.Context, conf *Config, next bool) ([]string, error) {
 	var url string
 	if next {
-		url = c.Next
+		url = conf.Next
 	} else {
-		url = c.Previous
+		url = conf.Previous
 	}
 
 	var locationRes LocationResponse
-	locationRes, err := getData[LocationResponse](url)
+	locationRes, err := getData[LocationResponse](ctx, c, url)
 	if err != nil {
 		return nil, err
 	}
-	setConfig(c, locationRes)
+	setConfig(conf, locationRes)
 	return getLocationsFromResponse(locationRes), nil
 }
 
-func GetPokemonsInArea(area string) ([]string, error) {
-	url := "https://pokeapi.co/api/v2/location-area/" + area
-
-	var areaRes AreaResponse
-	areaRes, err := getData[AreaResponse](url)
+func (c *Client) GetPokemonsInArea(ctx context.Context, area string) ([]Encounter, error) {
+	areaRes, err := c.GetLocationArea(ctx, area)
 	if err != nil {
 		return nil, err
 	}
 
-	pokemons := []string{}
-	for _, pokemon := range areaRes.PokemonEncounters {
-		pokemons = append(pokemons, pokemon.Pokemon.Name)
-	}
-
-	return pokemons, nil
+	return areaRes.Encounters(), nil
 }
 
-func GetPokemonInformation(pokemon string) (PokemonResponse, error) {
-	url := "https://pokeapi.co/api/v2/pokemon/" + pokemon
+func (c *Client) GetPokemonInformation(ctx context.Context, pokemon string) (PokemonResponse, error) {
+	url := c.endpoint("pokemon", pokemon)
 	var pokemonRes PokemonResponse
-	pokemonRes, err := getData[PokemonResponse](url)
+	pokemonRes, err := getData[PokemonResponse](ctx, c, url)
 	if err != nil {
 		return pokemonRes, err
 	}
 
 	return pokemonRes, nil
 }
+
+func (c *Client) getAllNames(ctx context.Context, resource string) ([]string, error) {
+	url := c.endpoint(resource) + "?limit=100000&offset=0"
+	listRes, err := getData[LocationResponse](ctx, c, url)
+	if err != nil {
+		return nil, err
+	}
+	return getLocationsFromResponse(listRes), nil
+}
+
+func (c *Client) GetAllPokemonNames(ctx context.Context) ([]string, error) {
+	return c.getAllNames(ctx, "pokemon")
+}
+
+func (c *Client) GetAllLocationAreaNames(ctx context.Context) ([]string, error) {
+	return c.getAllNames(ctx, "location-area")
+}
+
+// BaseStat returns the base value of the named stat, e.g. "hp" or "speed".
+func (p PokemonResponse) BaseStat(name string) int {
+	for _, s := range p.Stats {
+		if s.Stat.Name == name {
+			return s.BaseStat
+		}
+	}
+	return 0
+}
+
+func (c *Client) GetAllVersionNames(ctx context.Context) ([]string, error) {
+	return c.getAllNames(ctx, "version")
+}
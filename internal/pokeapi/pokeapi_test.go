@@ -0,0 +1,490 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClientBaseURL(t *testing.T) {
+	var gotPath, gotAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAgent = r.Header.Get("User-Agent")
+		fmt.Fprint(w, `{"name":"pikachu","base_experience":112}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL + "/api/v2/")
+	defer client.Close()
+	client.UserAgent = "pokedex-test"
+	pokemon, err := client.GetPokemonInformation(context.Background(), "pikachu")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotPath != "/api/v2/pokemon/pikachu" {
+		t.Errorf("expected request to /api/v2/pokemon/pikachu, got %s", gotPath)
+	}
+	if gotAgent != "pokedex-test" {
+		t.Errorf("expected user agent pokedex-test, got %s", gotAgent)
+	}
+	if pokemon.Name != "pikachu" || pokemon.BaseExperience != 112 {
+		t.Errorf("unexpected pokemon: %s %d", pokemon.Name, pokemon.BaseExperience)
+	}
+}
+
+func TestLocationPaging(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") == "0" {
+			fmt.Fprintf(w, `{"next":"%s/location-area?limit=20&offset=20","previous":null,"results":[{"name":"canalave-city-area"}]}`, server.URL)
+			return
+		}
+		fmt.Fprintf(w, `{"next":"","previous":"%s/location-area?limit=20&offset=0","results":[{"name":"eterna-city-area"}]}`, server.URL)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	defer client.Close()
+	conf := client.NewConfig()
+
+	locations, err := client.GetLocationAreaNames(context.Background(), conf, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(locations) != 1 || locations[0] != "canalave-city-area" {
+		t.Errorf("unexpected first page: %v", locations)
+	}
+
+	locations, err = client.GetLocationAreaNames(context.Background(), conf, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(locations) != 1 || locations[0] != "eterna-city-area" {
+		t.Errorf("unexpected second page: %v", locations)
+	}
+	if conf.Previous != server.URL+"/location-area?limit=20&offset=0" {
+		t.Errorf("unexpected previous page: %s", conf.Previous)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pokemon/pikachuu":
+			http.Error(w, "Not Found", http.StatusNotFound)
+		case "/pokemon/broken":
+			fmt.Fprint(w, "Not JSON")
+		default:
+			http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	defer client.Close()
+	client.Retry.BaseDelay = time.Millisecond
+
+	_, err := client.GetPokemonInformation(context.Background(), "pikachuu")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	_, err = client.GetPokemonInformation(context.Background(), "broken")
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Errorf("expected DecodeError, got %v", err)
+	}
+
+	_, err = client.GetPokemonsInArea(context.Background(), "pastoria-city-area")
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected HTTPError with status 503, got %v", err)
+	}
+	if errors.Is(err, ErrNotFound) {
+		t.Errorf("did not expect 503 to match ErrNotFound")
+	}
+}
+
+func TestCancel(t *testing.T) {
+	started := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	defer client.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	_, err := client.GetPokemonsInArea(ctx, "pastoria-city-area")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch calls.Add(1) {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+		case 2:
+			http.Error(w, "Bad Gateway", http.StatusBadGateway)
+		default:
+			fmt.Fprint(w, `{"name":"pikachu"}`)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	defer client.Close()
+	client.Retry = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+
+	pokemon, err := client.GetPokemonInformation(context.Background(), "pikachu")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pokemon.Name != "pikachu" {
+		t.Errorf("unexpected pokemon: %s", pokemon.Name)
+	}
+
+	stats := client.Stats()
+	if stats.Requests != 3 || stats.Retries != 2 || stats.Throttled != 1 || stats.Failures != 0 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	defer client.Close()
+	client.Retry = RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}
+
+	_, err := client.GetPokemonInformation(context.Background(), "pikachu")
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) {
+		t.Errorf("expected HTTPError, got %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("expected 2 attempts, got %d", calls.Load())
+	}
+	if stats := client.Stats(); stats.Failures != 1 {
+		t.Errorf("expected 1 failure, got %d", stats.Failures)
+	}
+}
+
+func TestRetryAfterTooLong(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "3600")
+		http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	defer client.Close()
+	client.Retry = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+
+	start := time.Now()
+	_, err := client.GetPokemonInformation(context.Background(), "pikachu")
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected a 429 HTTPError, got %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected to give up after 1 attempt, got %d", calls.Load())
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected to give up right away, took %v", elapsed)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		value    string
+		expected time.Duration
+	}{
+		{value: "", expected: 0},
+		{value: "3", expected: 3 * time.Second},
+		{value: "-1", expected: 0},
+		{value: "Mon, 01 Jan 2024 12:00:30 GMT", expected: 30 * time.Second},
+		{value: "Mon, 01 Jan 2024 11:00:00 GMT", expected: 0},
+		{value: "soon", expected: 0},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			if d := parseRetryAfter(c.value, now); d != c.expected {
+				t.Errorf("parseRetryAfter(%q) = %v, expected %v", c.value, d, c.expected)
+			}
+		})
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := NewRateLimiter(1000, 2)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if waited, _ := limiter.Wait(ctx); waited {
+			t.Errorf("expected burst request %d not to wait", i)
+		}
+	}
+	if waited, _ := limiter.Wait(ctx); !waited {
+		t.Errorf("expected request beyond burst to wait")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	slow := NewRateLimiter(0.001, 1)
+	slow.Wait(context.Background())
+	if _, err := slow.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestCacheIsPopulated(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		fmt.Fprint(w, `{"name":"pikachu"}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	defer client.Close()
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetPokemonInformation(context.Background(), "pikachu"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", calls.Load())
+	}
+}
+
+func TestCancelledWaiterStopsWaiting(t *testing.T) {
+	var g flightGroup
+	release := make(chan struct{})
+	started := make(chan struct{})
+	go g.do(context.Background(), "key", func() ([]byte, error) {
+		close(started)
+		<-release
+		return nil, nil
+	})
+	<-started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		_, err := g.do(ctx, "key", func() ([]byte, error) { return nil, nil })
+		done <- err
+	}()
+	deadline := time.Now().Add(5 * time.Second)
+	for g.waiting("key") < 1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the second caller to wait on the running call")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if n := g.waiting("key"); n != 0 {
+		t.Errorf("expected no callers waiting after cancel, got %d", n)
+	}
+	close(release)
+}
+
+func TestConcurrentFetchesAreShared(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+		fmt.Fprint(w, `{"name":"pikachu"}`)
+	}))
+	defer server.Close()
+
+	client := NewClientWithCache(server.URL, nil)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetPokemonInformation(context.Background(), "pikachu")
+			errs <- err
+		}()
+	}
+	url := client.endpoint("pokemon", "pikachu")
+	deadline := time.Now().Add(5 * time.Second)
+	for client.flights.waiting(url) < 4 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected 4 callers to wait on the shared fetch, got %d", client.flights.waiting(url))
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", calls.Load())
+	}
+}
+
+func TestAreaEncounters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"name": "canalave-city-area",
+			"encounter_method_rates": [
+				{"encounter_method": {"name": "surf"}, "version_details": [{"rate": 10, "version": {"name": "platinum"}}]}
+			],
+			"pokemon_encounters": [
+				{"pokemon": {"name": "tentacool"}, "version_details": [
+					{"version": {"name": "platinum"}, "max_chance": 65, "encounter_details": [
+						{"chance": 60, "min_level": 20, "max_level": 30, "method": {"name": "surf"}},
+						{"chance": 5, "min_level": 3, "max_level": 3, "method": {"name": "old-rod"},
+						 "condition_values": [{"name": "time-night"}, {"name": "swarm-no"}]}
+					]}
+				]}
+			]
+		}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	defer client.Close()
+
+	encounters, err := client.GetPokemonsInArea(context.Background(), "canalave-city-area")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(encounters) != 2 {
+		t.Fatalf("expected 2 encounters, got %d", len(encounters))
+	}
+	expected := Encounter{Area: "canalave-city-area", Pokemon: "tentacool", Version: "platinum", Method: "surf", Chance: 60, MinLevel: 20, MaxLevel: 30, MethodRate: 10, Conditions: []string{}}
+	if !reflect.DeepEqual(encounters[0], expected) {
+		t.Errorf("expected %+v, got %+v", expected, encounters[0])
+	}
+	if encounters[1].Method != "old-rod" || encounters[1].MethodRate != 0 || encounters[1].Condition() != "swarm-no, time-night" {
+		t.Errorf("unexpected second encounter: %+v", encounters[1])
+	}
+}
+
+func TestPokemonEncounters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/pokemon/pikachu":
+			fmt.Fprint(w, `{"name":"pikachu","location_area_encounters":"/api/v2/pokemon/25/encounters"}`)
+		case "/api/v2/pokemon/25/encounters":
+			fmt.Fprint(w, `[{"location_area":{"name":"viridian-forest-area"},"version_details":[
+				{"version":{"name":"red"},"encounter_details":[{"chance":5,"min_level":3,"max_level":5,"method":{"name":"walk"}}]}
+			]}]`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL + "/api/v2")
+	defer client.Close()
+
+	pokemon, err := client.GetPokemonInformation(context.Background(), "pikachu")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	encounters, err := client.GetPokemonEncounters(context.Background(), pokemon)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := Encounter{Area: "viridian-forest-area", Pokemon: "pikachu", Version: "red", Method: "walk", Chance: 5, MinLevel: 3, MaxLevel: 5, Conditions: []string{}}
+	if len(encounters) != 1 || !reflect.DeepEqual(encounters[0], expected) {
+		t.Errorf("expected [%+v], got %+v", expected, encounters)
+	}
+}
+
+func TestTypeChart(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch r.URL.Path {
+		case "/type/ice":
+			fmt.Fprint(w, `{"name": "ice", "damage_relations": {
+				"double_damage_to": [{"name": "dragon"}, {"name": "flying"}, {"name": "grass"}, {"name": "ground"}],
+				"half_damage_to": [{"name": "fire"}, {"name": "water"}, {"name": "ice"}, {"name": "steel"}]
+			}}`)
+		case "/type/ground":
+			fmt.Fprint(w, `{"name": "ground", "damage_relations": {"no_damage_to": [{"name": "flying"}], "double_damage_to": [{"name": "fire"}]}}`)
+		default:
+			fmt.Fprint(w, `{"damage_relations": {}}`)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	client.Limiter = nil
+	defer client.Close()
+
+	chart, err := client.GetTypeChart(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		attacking string
+		defending []string
+		expected  float64
+	}{
+		{"ice", []string{"dragon", "flying"}, 4},
+		{"ice", []string{"water", "steel"}, 0.25},
+		{"ice", []string{"grass", "water"}, 1},
+		{"ground", []string{"fire", "flying"}, 0},
+		{"normal", []string{"fire"}, 1},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			if actual := chart.Effectiveness(c.attacking, c.defending...); actual != c.expected {
+				t.Errorf("expected %v, got %v", c.expected, actual)
+			}
+		})
+	}
+
+	fetched := requests.Load()
+	if _, err := client.GetTypeChart(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests.Load() != fetched {
+		t.Errorf("expected the chart to be reused")
+	}
+}
@@ -0,0 +1,63 @@
+package pokeapi
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket allowing rate requests per second with
+// bursts of up to burst requests.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long the caller has to wait before
+// using it. The bucket may go negative so waiting callers queue up in order.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	l.tokens = min(l.burst, l.tokens+1)
+	l.mu.Unlock()
+}
+
+// Wait blocks until a request is allowed or ctx is done. It reports whether
+// the caller had to wait.
+func (l *RateLimiter) Wait(ctx context.Context) (bool, error) {
+	if l.rate <= 0 {
+		return false, ctx.Err()
+	}
+	delay := l.reserve()
+	if err := sleep(ctx, delay); err != nil {
+		l.cancel()
+		return delay > 0, err
+	}
+	return delay > 0, nil
+}
@@ -0,0 +1,83 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// flightGroup collapses concurrent fetches of the same URL into one request
+// whose result is shared by every caller.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flight
+}
+
+type flight struct {
+	done chan struct{}
+	data []byte
+	err  error
+
+	// waiters counts the callers blocked on done, not counting the one
+	// running the fetch. Guarded by flightGroup.mu.
+	waiters int
+}
+
+// do runs fn for key unless a call for key is already running, in which
+// case it waits for that call instead. If the shared call was cancelled by
+// its own caller while ctx is still live, the fetch is attempted again.
+func (g *flightGroup) do(ctx context.Context, key string, fn func() ([]byte, error)) ([]byte, error) {
+	for {
+		g.mu.Lock()
+		if g.calls == nil {
+			g.calls = make(map[string]*flight)
+		}
+		if f, ok := g.calls[key]; ok {
+			f.waiters++
+			g.mu.Unlock()
+			var err error
+			select {
+			case <-ctx.Done():
+				err = ctx.Err()
+			case <-f.done:
+			}
+			g.mu.Lock()
+			f.waiters--
+			g.mu.Unlock()
+			if err != nil {
+				return nil, err
+			}
+			if isContextErr(f.err) && ctx.Err() == nil {
+				continue
+			}
+			return f.data, f.err
+		}
+
+		f := &flight{done: make(chan struct{})}
+		g.calls[key] = f
+		g.mu.Unlock()
+
+		f.data, f.err = fn()
+
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(f.done)
+
+		return f.data, f.err
+	}
+}
+
+// waiting returns how many callers are waiting on the running call for key.
+func (g *flightGroup) waiting(key string) int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if f, ok := g.calls[key]; ok {
+		return f.waiters
+	}
+	return 0
+}
+
+func isContextErr(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,119 @@
+package pokeapi
+
+import (
+	"context"
+	"strings"
+)
+
+type SpeciesResponse struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	CaptureRate   int    `json:"capture_rate"`
+	BaseHappiness int    `json:"base_happiness"`
+	GenderRate    int    `json:"gender_rate"`
+	IsBaby        bool   `json:"is_baby"`
+	IsLegendary   bool   `json:"is_legendary"`
+	IsMythical    bool   `json:"is_mythical"`
+	Color         struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"color"`
+	Habitat *struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"habitat"`
+	Generation struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"generation"`
+	GrowthRate struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"growth_rate"`
+	EvolutionChain struct {
+		URL string `json:"url"`
+	} `json:"evolution_chain"`
+	EggGroups []struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"egg_groups"`
+	Genera []struct {
+		Genus    string `json:"genus"`
+		Language struct {
+			Name string `json:"name"`
+			URL  string `json:"url"`
+		} `json:"language"`
+	} `json:"genera"`
+	FlavorTextEntries []struct {
+		FlavorText string `json:"flavor_text"`
+		Language   struct {
+			Name string `json:"name"`
+			URL  string `json:"url"`
+		} `json:"language"`
+		Version struct {
+			Name string `json:"name"`
+			URL  string `json:"url"`
+		} `json:"version"`
+	} `json:"flavor_text_entries"`
+	Varieties []struct {
+		IsDefault bool `json:"is_default"`
+		Pokemon   struct {
+			Name string `json:"name"`
+			URL  string `json:"url"`
+		} `json:"pokemon"`
+	} `json:"varieties"`
+}
+
+func (c *Client) GetPokemonSpecies(ctx context.Context, species string) (SpeciesResponse, error) {
+	url := c.endpoint("pokemon-species", species)
+	return getData[SpeciesResponse](ctx, c, url)
+}
+
+// GetSpeciesOf fetches the species a Pokémon belongs to. Forms such as
+// "deoxys-attack" have no species of their own, so this follows the
+// species link in the Pokémon resource rather than reusing its name.
+func (c *Client) GetSpeciesOf(ctx context.Context, pokemon PokemonResponse) (SpeciesResponse, error) {
+	if pokemon.Species.URL == "" {
+		return c.GetPokemonSpecies(ctx, pokemon.Name)
+	}
+	return getData[SpeciesResponse](ctx, c, c.resolve(pokemon.Species.URL))
+}
+
+// DefaultVariety returns the name of the species' default Pokémon, such as
+// "deoxys-normal" for "deoxys".
+func (s SpeciesResponse) DefaultVariety() string {
+	for _, v := range s.Varieties {
+		if v.IsDefault {
+			return v.Pokemon.Name
+		}
+	}
+	return s.Name
+}
+
+// Genus returns the species' genus in the given language, e.g. "Mouse Pokémon".
+func (s SpeciesResponse) Genus(language string) string {
+	for _, g := range s.Genera {
+		if g.Language.Name == language {
+			return g.Genus
+		}
+	}
+	return ""
+}
+
+// FlavorText returns the Pokédex entry in the given language for version,
+// falling back to the most recent entry in that language when the version
+// has none or is empty. It also returns the version the entry is from.
+// Line and page breaks in the text are replaced with spaces.
+func (s SpeciesResponse) FlavorText(language, version string) (string, string) {
+	text, from := "", ""
+	for _, f := range s.FlavorTextEntries {
+		if f.Language.Name != language {
+			continue
+		}
+		text, from = f.FlavorText, f.Version.Name
+		if version != "" && f.Version.Name == version {
+			break
+		}
+	}
+	return strings.Join(strings.Fields(text), " "), from
+}
@@ -0,0 +1,30 @@
+package pokeapi
+
+import "sync/atomic"
+
+// Stats counts what the client's fetch layer has done so far.
+type Stats struct {
+	Requests    int64
+	Retries     int64
+	Throttled   int64
+	RateLimited int64
+	Failures    int64
+}
+
+type counters struct {
+	requests    atomic.Int64
+	retries     atomic.Int64
+	throttled   atomic.Int64
+	rateLimited atomic.Int64
+	failures    atomic.Int64
+}
+
+func (c *Client) Stats() Stats {
+	return Stats{
+		Requests:    c.stats.requests.Load(),
+		Retries:     c.stats.retries.Load(),
+		Throttled:   c.stats.throttled.Load(),
+		RateLimited: c.stats.rateLimited.Load(),
+		Failures:    c.stats.failures.Load(),
+	}
+}
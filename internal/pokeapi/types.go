@@ -0,0 +1,108 @@
+package pokeapi
+
+import (
+	"context"
+	"sync"
+)
+
+// TypeNames lists the 18 types used in the mainline games' matchup chart.
+var TypeNames = []string{
+	"normal", "fire", "water", "electric", "grass", "ice",
+	"fighting", "poison", "ground", "flying", "psychic", "bug",
+	"rock", "ghost", "dragon", "dark", "steel", "fairy",
+}
+
+type TypeResponse struct {
+	ID              int    `json:"id"`
+	Name            string `json:"name"`
+	DamageRelations struct {
+		NoDamageTo       []NamedResource `json:"no_damage_to"`
+		HalfDamageTo     []NamedResource `json:"half_damage_to"`
+		DoubleDamageTo   []NamedResource `json:"double_damage_to"`
+		NoDamageFrom     []NamedResource `json:"no_damage_from"`
+		HalfDamageFrom   []NamedResource `json:"half_damage_from"`
+		DoubleDamageFrom []NamedResource `json:"double_damage_from"`
+	} `json:"damage_relations"`
+}
+
+func (c *Client) GetType(ctx context.Context, name string) (TypeResponse, error) {
+	url := c.endpoint("type", name)
+	return getData[TypeResponse](ctx, c, url)
+}
+
+// TypeChart maps an attacking type to the multiplier it deals against each
+// defending type. Pairs that are missing deal neutral (1x) damage.
+type TypeChart map[string]map[string]float64
+
+// Effectiveness returns the multiplier of an attacking type against a
+// Pokémon with the given defending types, e.g. 4 for ice against
+// dragon/flying.
+func (t TypeChart) Effectiveness(attacking string, defending ...string) float64 {
+	multiplier := 1.0
+	for _, d := range defending {
+		if m, ok := t[attacking][d]; ok {
+			multiplier *= m
+		}
+	}
+	return multiplier
+}
+
+// GetTypeChart loads the damage relations of every type in TypeNames,
+// fetching them in parallel. The chart is built once per client; callers
+// racing on a cold chart share the underlying requests.
+func (c *Client) GetTypeChart(ctx context.Context) (TypeChart, error) {
+	c.typeChartMu.Lock()
+	chart := c.typeChart
+	c.typeChartMu.Unlock()
+	if chart != nil {
+		return chart, nil
+	}
+
+	types := make([]TypeResponse, len(TypeNames))
+	errs := make([]error, len(TypeNames))
+	var wg sync.WaitGroup
+	for i, name := range TypeNames {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			types[i], errs[i] = c.GetType(ctx, name)
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	chart = TypeChart{}
+	for i, t := range types {
+		relations := map[string]float64{}
+		for _, r := range t.DamageRelations.NoDamageTo {
+			relations[r.Name] = 0
+		}
+		for _, r := range t.DamageRelations.HalfDamageTo {
+			relations[r.Name] = 0.5
+		}
+		for _, r := range t.DamageRelations.DoubleDamageTo {
+			relations[r.Name] = 2
+		}
+		chart[TypeNames[i]] = relations
+	}
+
+	c.typeChartMu.Lock()
+	defer c.typeChartMu.Unlock()
+	if c.typeChart == nil {
+		c.typeChart = chart
+	}
+	return c.typeChart, nil
+}
+
+// TypesOf returns the names of a Pokémon's types.
+func (p PokemonResponse) TypesOf() []string {
+	names := []string{}
+	for _, t := range p.Types {
+		names = append(names, t.Type.Name)
+	}
+	return names
+}
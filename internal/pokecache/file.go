@@ -0,0 +1,299 @@
+package pokecache
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const fileExt = ".entry"
+
+// staleTemp is how old a temporary file left by write must be before load
+// treats it as left behind by a crash rather than a write in progress.
+const staleTemp = time.Minute
+
+// FileStore is a Store keeping entries as files in a directory so they
+// survive between sessions. Each file holds a one line JSON header followed by the raw value.
+// Unreadable or damaged files are ignored and removed.
+type FileStore struct {
+	dir      string
+	ttl      time.Duration
+	maxBytes int64
+
+	mu    sync.Mutex
+	index map[string]fileEntry
+	size  int64
+}
+
+type fileEntry struct {
+	Key       string    `json:"key"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Size      int64     `json:"size"`
+	Checksum  uint32    `json:"checksum"`
+
+	file     string
+	fileSize int64
+}
+
+// DefaultDir returns $XDG_CACHE_HOME/pokedex, or the platform equivalent.
+func DefaultDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "pokedex"), nil
+}
+
+// NewFileStore opens (creating if needed) a cache in dir. Entries expire
+// after ttl unless added with AddWithTTL, and the oldest entries are evicted
+// once the files exceed maxBytes. A maxBytes of zero means no limit.
+func NewFileStore(dir string, ttl time.Duration, maxBytes int64) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	s := &FileStore{
+		dir:      dir,
+		ttl:      ttl,
+		maxBytes: maxBytes,
+		index:    make(map[string]fileEntry),
+	}
+	s.load()
+	return s, nil
+}
+
+func (s *FileStore) load() {
+	files, err := os.ReadDir(s.dir)
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	for _, f := range files {
+		path := filepath.Join(s.dir, f.Name())
+		if !f.IsDir() && strings.Contains(f.Name(), fileExt+".tmp") {
+			if info, err := f.Info(); err == nil && now.Sub(info.ModTime()) > staleTemp {
+				os.Remove(path)
+			}
+			continue
+		}
+		if f.IsDir() || !strings.HasSuffix(f.Name(), fileExt) {
+			continue
+		}
+		entry, ok := readHeader(path)
+		if !ok || now.After(entry.ExpiresAt) {
+			os.Remove(path)
+			continue
+		}
+		s.index[entry.Key] = entry
+		s.size += entry.fileSize
+	}
+	s.evict()
+}
+
+func readHeader(path string) (fileEntry, bool) {
+	var entry fileEntry
+
+	f, err := os.Open(path)
+	if err != nil {
+		return entry, false
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return entry, false
+	}
+	header, err := bufio.NewReader(f).ReadBytes('\n')
+	if err != nil {
+		return entry, false
+	}
+	if err := json.Unmarshal(header, &entry); err != nil {
+		return entry, false
+	}
+	if int64(len(header))+entry.Size != info.Size() {
+		return entry, false
+	}
+
+	entry.file = filepath.Base(path)
+	entry.fileSize = info.Size()
+	return entry, entry.file == fileName(entry.Key)
+}
+
+func fileName(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:]) + fileExt
+}
+
+func (s *FileStore) Add(key string, value []byte) {
+	s.AddWithTTL(key, value, s.ttl)
+}
+
+func (s *FileStore) AddWithTTL(key string, value []byte, ttl time.Duration) {
+	now := time.Now()
+	entry := fileEntry{
+		Key:       key,
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+		Size:      int64(len(value)),
+		Checksum:  crc32.ChecksumIEEE(value),
+		file:      fileName(key),
+	}
+	header, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	header = append(header, '\n')
+	entry.fileSize = int64(len(header) + len(value))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.write(entry.file, header, value); err != nil {
+		return
+	}
+	if old, ok := s.index[key]; ok {
+		s.size -= old.fileSize
+	}
+	s.index[key] = entry
+	s.size += entry.fileSize
+	s.evict()
+}
+
+func (s *FileStore) write(name string, header, value []byte) error {
+	tmp, err := os.CreateTemp(s.dir, name+".tmp*")
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(tmp, io.MultiReader(bytes.NewReader(header), bytes.NewReader(value)))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), filepath.Join(s.dir, name))
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+func (s *FileStore) Get(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.index[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.ExpiresAt) {
+		s.remove(key)
+		return nil, false
+	}
+
+	data, err := os.ReadFile(filepath.Join(s.dir, entry.file))
+	if err != nil || int64(len(data)) != entry.fileSize {
+		s.remove(key)
+		return nil, false
+	}
+	value := data[entry.fileSize-entry.Size:]
+	if crc32.ChecksumIEEE(value) != entry.Checksum {
+		s.remove(key)
+		return nil, false
+	}
+	return value, true
+}
+
+// Contains reports whether key has an entry that has not expired, without
+// reading its file.
+func (s *FileStore) Contains(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.index[key]
+	if !ok {
+		return false
+	}
+	if time.Now().After(entry.ExpiresAt) {
+		s.remove(key)
+		return false
+	}
+	return true
+}
+
+func (s *FileStore) Delete(key string) {
+	s.mu.Lock()
+	s.remove(key)
+	s.mu.Unlock()
+}
+
+func (s *FileStore) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, 0, len(s.index))
+	for key := range s.index {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (s *FileStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.index)
+}
+
+// Close is a no-op; every Add is already on disk.
+func (s *FileStore) Close() error {
+	return nil
+}
+
+func (s *FileStore) remove(key string) {
+	entry, ok := s.index[key]
+	if !ok {
+		return
+	}
+	os.Remove(filepath.Join(s.dir, entry.file))
+	s.size -= entry.fileSize
+	delete(s.index, key)
+}
+
+// evict drops expired entries and then the oldest ones until the cache
+// fits in maxBytes.
+func (s *FileStore) evict() {
+	now := time.Now()
+	for key, entry := range s.index {
+		if now.After(entry.ExpiresAt) {
+			s.remove(key)
+		}
+	}
+	if s.maxBytes <= 0 || s.size <= s.maxBytes {
+		return
+	}
+
+	entries := make([]fileEntry, 0, len(s.index))
+	for _, entry := range s.index {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.Before(entries[j].CreatedAt)
+	})
+	for _, entry := range entries {
+		if s.size <= s.maxBytes {
+			break
+		}
+		s.remove(entry.Key)
+	}
+}
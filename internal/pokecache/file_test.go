@@ -0,0 +1,140 @@
+package pokecache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileStorePersists(t *testing.T) {
+	dir := t.TempDir()
+	disk, err := NewFileStore(dir, time.Hour, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	disk.Add("https://example.com", []byte("testdata"))
+
+	reopened, err := NewFileStore(dir, time.Hour, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	val, ok := reopened.Get("https://example.com")
+	if !ok {
+		t.Errorf("expected to find key")
+		return
+	}
+	if string(val) != "testdata" {
+		t.Errorf("expected to find value")
+	}
+}
+
+func TestFileStoreTTL(t *testing.T) {
+	disk, err := NewFileStore(t.TempDir(), time.Hour, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	disk.AddWithTTL("https://example.com", []byte("testdata"), -time.Second)
+	disk.Add("https://example.com/path", []byte("moretestdata"))
+
+	if _, ok := disk.Get("https://example.com"); ok {
+		t.Errorf("expected expired key to be gone")
+	}
+	if _, ok := disk.Get("https://example.com/path"); !ok {
+		t.Errorf("expected to find key")
+	}
+}
+
+func TestFileStoreCorruption(t *testing.T) {
+	dir := t.TempDir()
+	disk, err := NewFileStore(dir, time.Hour, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	disk.Add("https://example.com", []byte("testdata"))
+	disk.Add("https://example.com/path", []byte("moretestdata"))
+
+	path := filepath.Join(dir, fileName("https://example.com"))
+	data, _ := os.ReadFile(path)
+	data[len(data)-1] ^= 0xff
+	os.WriteFile(path, data, 0644)
+	os.WriteFile(filepath.Join(dir, fileName("https://example.com/path")), []byte("garbage"), 0644)
+	os.WriteFile(filepath.Join(dir, "stray"+fileExt), []byte("{}\n"), 0644)
+
+	reopened, err := NewFileStore(dir, time.Hour, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := reopened.Get("https://example.com"); ok {
+		t.Errorf("expected corrupted value to be ignored")
+	}
+	if _, ok := reopened.Get("https://example.com/path"); ok {
+		t.Errorf("expected corrupted header to be ignored")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "stray"+fileExt)); !os.IsNotExist(err) {
+		t.Errorf("expected stray file to be removed")
+	}
+}
+
+func TestFileStoreRemovesStaleTempFiles(t *testing.T) {
+	dir := t.TempDir()
+	stale := filepath.Join(dir, fileName("https://example.com")+".tmp123")
+	fresh := filepath.Join(dir, fileName("https://example.com/path")+".tmp456")
+	os.WriteFile(stale, []byte("partial"), 0644)
+	os.WriteFile(fresh, []byte("partial"), 0644)
+	old := time.Now().Add(-time.Hour)
+	os.Chtimes(stale, old, old)
+
+	if _, err := NewFileStore(dir, time.Hour, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Errorf("expected stale temp file to be removed")
+	}
+	if _, err := os.Stat(fresh); err != nil {
+		t.Errorf("expected temp file of a write in progress to be kept")
+	}
+}
+
+func TestFileStoreSizeLimit(t *testing.T) {
+	disk, err := NewFileStore(t.TempDir(), time.Hour, 400)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	disk.Add("https://example.com/1", make([]byte, 150))
+	time.Sleep(time.Millisecond)
+	disk.Add("https://example.com/2", make([]byte, 150))
+
+	if _, ok := disk.Get("https://example.com/1"); ok {
+		t.Errorf("expected oldest key to be evicted")
+	}
+	if _, ok := disk.Get("https://example.com/2"); !ok {
+		t.Errorf("expected newest key to be kept")
+	}
+}
+
+func TestPersistentCache(t *testing.T) {
+	dir := t.TempDir()
+	disk, _ := NewFileStore(dir, time.Hour, 0)
+	NewPersistentCache(16, disk).Add("https://example.com", []byte("testdata"))
+
+	disk, _ = NewFileStore(dir, time.Hour, 0)
+	val, ok := NewPersistentCache(16, disk).Get("https://example.com")
+	if !ok || string(val) != "testdata" {
+		t.Errorf("expected to find persisted value")
+	}
+}
+
+func TestPersistentCacheExpires(t *testing.T) {
+	disk, _ := NewFileStore(t.TempDir(), time.Hour, 0)
+	cache := NewPersistentCache(16, disk)
+	cache.AddWithTTL("https://example.com", []byte("testdata"), -time.Second)
+	cache.Add("https://example.com/path", []byte("moretestdata"))
+
+	if _, ok := cache.Get("https://example.com"); ok {
+		t.Errorf("expected expired key to be gone from memory too")
+	}
+	if _, ok := cache.Get("https://example.com/path"); !ok {
+		t.Errorf("expected to find key")
+	}
+}
@@ -0,0 +1,90 @@
+package pokecache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// LRUStore is a Store holding at most capacity entries, evicting the least
+// recently used one when full.
+type LRUStore struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	items    map[string]*list.Element
+}
+
+type lruItem struct {
+	key string
+	val []byte
+}
+
+func NewLRUStore(capacity int) *LRUStore {
+	return &LRUStore{
+		capacity: max(1, capacity),
+		order:    list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+func (s *LRUStore) Get(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elem, ok := s.items[key]
+	if !ok {
+		return nil, false
+	}
+	s.order.MoveToFront(elem)
+	return elem.Value.(*lruItem).val, true
+}
+
+func (s *LRUStore) Add(key string, value []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if elem, ok := s.items[key]; ok {
+		elem.Value.(*lruItem).val = value
+		s.order.MoveToFront(elem)
+		return
+	}
+
+	s.items[key] = s.order.PushFront(&lruItem{key: key, val: value})
+	for s.order.Len() > s.capacity {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.items, oldest.Value.(*lruItem).key)
+	}
+}
+
+func (s *LRUStore) Delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if elem, ok := s.items[key]; ok {
+		s.order.Remove(elem)
+		delete(s.items, key)
+	}
+}
+
+// Keys returns the keys from most to least recently used.
+func (s *LRUStore) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, 0, s.order.Len())
+	for elem := s.order.Front(); elem != nil; elem = elem.Next() {
+		keys = append(keys, elem.Value.(*lruItem).key)
+	}
+	return keys
+}
+
+func (s *LRUStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.order.Len()
+}
+
+func (s *LRUStore) Close() error {
+	return nil
+}
@@ -0,0 +1,86 @@
+package pokecache
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestLRUStore(t *testing.T) {
+	store := NewLRUStore(2)
+	store.Add("https://example.com/1", []byte("one"))
+	store.Add("https://example.com/2", []byte("two"))
+	store.Get("https://example.com/1")
+	store.Add("https://example.com/3", []byte("three"))
+
+	if _, ok := store.Get("https://example.com/2"); ok {
+		t.Errorf("expected least recently used key to be evicted")
+	}
+	if store.Len() != 2 {
+		t.Errorf("expected 2 entries, got %d", store.Len())
+	}
+	expected := []string{"https://example.com/3", "https://example.com/1"}
+	if keys := store.Keys(); !slices.Equal(keys, expected) {
+		t.Errorf("expected keys %v, got %v", expected, keys)
+	}
+
+	store.Delete("https://example.com/1")
+	if _, ok := store.Get("https://example.com/1"); ok {
+		t.Errorf("expected deleted key to be gone")
+	}
+}
+
+type fakeStore struct {
+	added map[string][]byte
+}
+
+func (s *fakeStore) Get(key string) ([]byte, bool) {
+	val, ok := s.added[key]
+	return val, ok
+}
+
+func (s *fakeStore) Add(key string, value []byte) {
+	s.added[key] = value
+}
+
+func (s *fakeStore) Delete(key string) {
+	delete(s.added, key)
+}
+
+func (s *fakeStore) Keys() []string {
+	keys := []string{}
+	for key := range s.added {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (s *fakeStore) Len() int {
+	return len(s.added)
+}
+
+func (s *fakeStore) Close() error {
+	return nil
+}
+
+func TestTieredStore(t *testing.T) {
+	back := &fakeStore{added: map[string][]byte{"https://example.com": []byte("testdata")}}
+	front := NewLRUStore(4)
+	cache := NewCacheWithStore(NewTieredStore(front, back))
+
+	val, ok := cache.Get("https://example.com")
+	if !ok || string(val) != "testdata" {
+		t.Errorf("expected to find value from back store")
+	}
+	if _, ok := front.Get("https://example.com"); !ok {
+		t.Errorf("expected hit to be copied into front store")
+	}
+
+	cache.AddWithTTL("https://example.com/path", []byte("moretestdata"), time.Hour)
+	if _, ok := back.Get("https://example.com/path"); !ok {
+		t.Errorf("expected add to reach back store")
+	}
+	if cache.Len() != 2 {
+		t.Errorf("expected 2 entries, got %d", cache.Len())
+	}
+}
@@ -0,0 +1,123 @@
+package pokecache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore is a Store keeping entries in a map, reaping them once they
+// are older than interval. The reaper runs until Close is called or the
+// context given to NewMemoryStoreContext is done.
+type MemoryStore struct {
+	cache    map[string]cacheEntry
+	mu       sync.Mutex
+	interval time.Duration
+	clock    Clock
+	cancel   context.CancelFunc
+	stopped  chan struct{}
+}
+
+type cacheEntry struct {
+	createdAt time.Time
+	val       []byte
+}
+
+func NewMemoryStore(interval time.Duration) *MemoryStore {
+	return NewMemoryStoreContext(context.Background(), interval, nil)
+}
+
+// NewMemoryStoreContext is like NewMemoryStore but stops reaping when ctx is
+// done and reads time from clock. A nil clock uses the system time.
+func NewMemoryStoreContext(ctx context.Context, interval time.Duration, clock Clock) *MemoryStore {
+	if clock == nil {
+		clock = realClock{}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	store := &MemoryStore{
+		interval: interval,
+		clock:    clock,
+		cache:    make(map[string]cacheEntry),
+		cancel:   cancel,
+		stopped:  make(chan struct{}),
+	}
+
+	go store.reapLoop(ctx)
+
+	return store
+}
+
+func (s *MemoryStore) Add(key string, value []byte) {
+	s.mu.Lock()
+	s.cache[key] = cacheEntry{createdAt: s.clock.Now(), val: value}
+	s.mu.Unlock()
+}
+
+func (s *MemoryStore) Get(key string) ([]byte, bool) {
+	s.mu.Lock()
+	val, ok := s.cache[key]
+	s.mu.Unlock()
+	if ok && !s.expired(val) {
+		return val.val, true
+	}
+	return nil, false
+}
+
+func (s *MemoryStore) Delete(key string) {
+	s.mu.Lock()
+	delete(s.cache, key)
+	s.mu.Unlock()
+}
+
+func (s *MemoryStore) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, 0, len(s.cache))
+	for key := range s.cache {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.cache)
+}
+
+// Close stops the reaper goroutine and waits for it to exit.
+func (s *MemoryStore) Close() error {
+	s.cancel()
+	<-s.stopped
+	return nil
+}
+
+func (s *MemoryStore) expired(entry cacheEntry) bool {
+	return s.clock.Now().Sub(entry.createdAt) > s.interval
+}
+
+func (s *MemoryStore) reap() {
+	s.mu.Lock()
+	for k, v := range s.cache {
+		if s.expired(v) {
+			delete(s.cache, k)
+		}
+	}
+	s.mu.Unlock()
+}
+
+func (s *MemoryStore) reapLoop(ctx context.Context) {
+	defer close(s.stopped)
+	ticker := s.clock.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+			s.reap()
+		}
+	}
+}
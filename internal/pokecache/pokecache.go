@@ -1,61 +1,68 @@
 package pokecache
 
 import (
-	"sync"
+	"context"
 	"time"
 )
 
+// Cache is the entry point used by the rest of the program. It delegates to
+// the Store chosen at construction.
 type Cache struct {
-	cache    map[string]cacheEntry
-	mu       sync.Mutex
-	interval time.Duration
+	store Store
 }
 
-type cacheEntry struct {
-	createdAt time.Time
-	val       []byte
+// NewCache returns a Cache backed by a MemoryStore that drops entries older
+// than interval.
+func NewCache(interval time.Duration) *Cache {
+	return NewCacheWithStore(NewMemoryStore(interval))
+}
+
+// NewCacheContext is like NewCache but the reaper stops once ctx is done.
+func NewCacheContext(ctx context.Context, interval time.Duration) *Cache {
+	return NewCacheWithStore(NewMemoryStoreContext(ctx, interval, nil))
+}
+
+func NewCacheWithStore(store Store) *Cache {
+	return &Cache{store: store}
+}
+
+// NewPersistentCache returns a Cache that keeps up to entries recently used
+// values in memory and writes everything through to files.
+func NewPersistentCache(entries int, files *FileStore) *Cache {
+	return NewCacheWithStore(NewTieredStore(NewLRUStore(entries), files))
 }
 
 func (c *Cache) Add(key string, value []byte) {
-	c.mu.Lock()
-	c.cache[key] = cacheEntry{createdAt: time.Now(), val: value}
-	c.mu.Unlock()
+	c.store.Add(key, value)
 }
 
-func (c *Cache) Get(key string) ([]byte, bool) {
-	c.mu.Lock()
-	val, ok := c.cache[key]
-	c.mu.Unlock()
-	if ok {
-		return val.val, ok
-	}
-	return nil, false
-}
-
-func (c *Cache) reapLoop() {
-	interval := c.interval
-	ticker := time.NewTicker(interval)
-	defer ticker.Stop()
-
-	for range ticker.C {
-		c.mu.Lock()
-		for k, v := range c.cache {
-			if time.Since(v.createdAt) > interval {
-				delete(c.cache, k)
-			}
-		}
-		c.mu.Unlock()
+// AddWithTTL is like Add but asks the store to keep the entry for ttl. Stores
+// without per-entry expiry fall back to Add.
+func (c *Cache) AddWithTTL(key string, value []byte, ttl time.Duration) {
+	if s, ok := c.store.(ttlStore); ok {
+		s.AddWithTTL(key, value, ttl)
+		return
 	}
+	c.store.Add(key, value)
 }
 
-func NewCache(interval time.Duration) *Cache {
-	cache := Cache{
-		interval: interval,
-		mu:       sync.Mutex{},
-		cache:    make(map[string]cacheEntry),
-	}
+func (c *Cache) Get(key string) ([]byte, bool) {
+	return c.store.Get(key)
+}
 
-	go cache.reapLoop()
+func (c *Cache) Delete(key string) {
+	c.store.Delete(key)
+}
+
+func (c *Cache) Keys() []string {
+	return c.store.Keys()
+}
+
+func (c *Cache) Len() int {
+	return c.store.Len()
+}
 
-	return &cache
+// Close releases the store, stopping any background goroutines it runs.
+func (c *Cache) Close() error {
+	return c.store.Close()
 }
@@ -1,7 +1,9 @@
 package pokecache
 
 import (
+	"context"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 )
@@ -25,6 +27,7 @@ func TestAddGet(t *testing.T) {
 	for i, c := range cases {
 		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
 			cache := NewCache(interval)
+			defer cache.Close()
 			cache.Add(c.key, c.val)
 			val, ok := cache.Get(c.key)
 			if !ok {
@@ -43,6 +46,7 @@ func TestReapLoop(t *testing.T) {
 	const baseTime = 5 * time.Millisecond
 	const waitTime = baseTime + 5*time.Millisecond
 	cache := NewCache(baseTime)
+	defer cache.Close()
 	cache.Add("https://example.com", []byte("testdata"))
 
 	_, ok := cache.Get("https://example.com")
@@ -59,3 +63,96 @@ func TestReapLoop(t *testing.T) {
 		return
 	}
 }
+
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	ticker *fakeTicker
+}
+
+type fakeTicker struct {
+	c chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{
+		now:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		ticker: &fakeTicker{c: make(chan time.Time)},
+	}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) NewTicker(d time.Duration) Ticker {
+	return c.ticker
+}
+
+// Advance moves the clock forward and delivers a tick. The tick channel is
+// unbuffered, so once a second Advance returns the reap triggered by the
+// first one has finished.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	now := c.now
+	c.mu.Unlock()
+	c.ticker.c <- now
+}
+
+func (t *fakeTicker) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTicker) Stop() {}
+
+func TestReapLoopWithClock(t *testing.T) {
+	const interval = 5 * time.Second
+	clock := newFakeClock()
+	store := NewMemoryStoreContext(context.Background(), interval, clock)
+	defer store.Close()
+
+	store.Add("https://example.com", []byte("testdata"))
+	clock.Advance(interval)
+	store.Add("https://example.com/path", []byte("moretestdata"))
+
+	if _, ok := store.Get("https://example.com"); !ok {
+		t.Errorf("expected to find key before it expires")
+	}
+
+	clock.Advance(time.Second)
+	clock.Advance(0)
+
+	if _, ok := store.Get("https://example.com"); ok {
+		t.Errorf("expected to not find key")
+	}
+	if store.Len() != 1 {
+		t.Errorf("expected expired key to be reaped, got %d entries", store.Len())
+	}
+}
+
+func TestCloseStopsReaper(t *testing.T) {
+	store := NewMemoryStoreContext(context.Background(), time.Second, newFakeClock())
+	store.Close()
+
+	select {
+	case <-store.stopped:
+	default:
+		t.Errorf("expected reaper to have stopped")
+	}
+	store.Close()
+}
+
+func TestContextStopsReaper(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	store := NewMemoryStoreContext(ctx, time.Second, newFakeClock())
+	cancel()
+
+	select {
+	case <-store.stopped:
+	case <-time.After(time.Second):
+		t.Errorf("expected reaper to stop when context is done")
+	}
+}
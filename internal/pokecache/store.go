@@ -0,0 +1,96 @@
+package pokecache
+
+import "time"
+
+// Store is a key/value store for cached responses.
+type Store interface {
+	Get(key string) ([]byte, bool)
+	Add(key string, value []byte)
+	Delete(key string)
+	Keys() []string
+	Len() int
+	Close() error
+}
+
+// ttlStore is implemented by stores that support a per-entry time to live.
+type ttlStore interface {
+	AddWithTTL(key string, value []byte, ttl time.Duration)
+}
+
+// containsStore is implemented by stores that can cheaply tell whether they
+// still hold a key.
+type containsStore interface {
+	Contains(key string) bool
+}
+
+// TieredStore serves reads from front when it can and falls back to back,
+// copying hits into front. Writes go to both. When back can tell, a front hit
+// only counts if back still holds the key, so entries back has expired or
+// evicted don't live on in front.
+type TieredStore struct {
+	front Store
+	back  Store
+}
+
+func NewTieredStore(front, back Store) *TieredStore {
+	return &TieredStore{front: front, back: back}
+}
+
+func (t *TieredStore) Get(key string) ([]byte, bool) {
+	if val, ok := t.front.Get(key); ok {
+		back, ok := t.back.(containsStore)
+		if !ok || back.Contains(key) {
+			return val, true
+		}
+		t.front.Delete(key)
+		return nil, false
+	}
+	val, ok := t.back.Get(key)
+	if ok {
+		t.front.Add(key, val)
+	}
+	return val, ok
+}
+
+func (t *TieredStore) Add(key string, value []byte) {
+	t.front.Add(key, value)
+	t.back.Add(key, value)
+}
+
+func (t *TieredStore) AddWithTTL(key string, value []byte, ttl time.Duration) {
+	t.front.Add(key, value)
+	if s, ok := t.back.(ttlStore); ok {
+		s.AddWithTTL(key, value, ttl)
+	} else {
+		t.back.Add(key, value)
+	}
+}
+
+func (t *TieredStore) Delete(key string) {
+	t.front.Delete(key)
+	t.back.Delete(key)
+}
+
+func (t *TieredStore) Keys() []string {
+	seen := map[string]bool{}
+	keys := []string{}
+	for _, key := range append(t.front.Keys(), t.back.Keys()...) {
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func (t *TieredStore) Len() int {
+	return len(t.Keys())
+}
+
+func (t *TieredStore) Close() error {
+	frontErr := t.front.Close()
+	if err := t.back.Close(); err != nil {
+		return err
+	}
+	return frontErr
+}
@@ -0,0 +1,174 @@
+package repl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/game"
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+const MAX_TURNS = 100
+
+// moveSet picks the last damaging moves a Pokémon learned by level, like a
+// wild Pokémon in the games.
+func moveSet(ctx context.Context, pokemon pokeapi.PokemonResponse, level int) ([]game.Move, error) {
+	names := pokemon.LevelUpMoves(level)
+	moves := []game.Move{}
+	for i := len(names) - 1; i >= 0 && len(moves) < game.MaxMoves; i-- {
+		m, err := client.GetMove(ctx, names[i])
+		if err != nil {
+			return nil, friendlyError(ctx, err, "move", names[i], nil)
+		}
+		if move, ok := game.MoveFrom(m); ok {
+			moves = append(moves, move)
+		}
+	}
+	return moves, nil
+}
+
+func newBattler(ctx context.Context, pokemon pokeapi.PokemonResponse, level int) (*game.Battler, error) {
+	moves, err := moveSet(ctx, pokemon, level)
+	if err != nil {
+		return nil, err
+	}
+	return game.NewBattler(pokemon, level, moves), nil
+}
+
+// newOwnedBattler prepares an owned pokemon, showing it by its nickname.
+func newOwnedBattler(ctx context.Context, o *game.Owned) (*game.Battler, error) {
+	moves, err := moveSet(ctx, o.Pokemon, o.Level)
+	if err != nil {
+		return nil, err
+	}
+	n, err := nature(ctx, o)
+	if err != nil {
+		return nil, err
+	}
+	return game.NewOwnedBattler(o, n, moves), nil
+}
+
+func describeBattler(b *game.Battler) string {
+	moves := []string{}
+	for _, m := range b.Moves {
+		moves = append(moves, m.Name)
+	}
+	if len(moves) == 0 {
+		moves = append(moves, game.Struggle.Name)
+	}
+	return fmt.Sprintf("%s (Lv. %d, HP %d/%d, %s) knows %s", b.Name, b.Level, b.HP, b.MaxHP, strings.Join(b.Types, "/"), strings.Join(moves, ", "))
+}
+
+func describeAttack(a game.Attack) string {
+	line := fmt.Sprintf("%s used %s!", a.Attacker.Name, a.Move.Name)
+	switch {
+	case a.Missed:
+		return line + " It missed!"
+	case a.Critical:
+		line += " A critical hit!"
+	}
+	return line + effectivenessNote(a.Effectiveness) + fmt.Sprintf(" %s: %d/%d HP", a.Defender.Name, a.Defender.HP, a.Defender.MaxHP)
+}
+
+func commandBattle(ctx context.Context, c *pokeapi.Config, args []string) error {
+	if len(args) == 0 {
+		fmt.Println("usage: battle <your-pokemon> [wild-or-your-pokemon]")
+		return nil
+	}
+	mine, ok := findOwned(args[0])
+	if !ok {
+		return nil
+	}
+
+	var opponentName string
+	if len(args) > 1 {
+		opponentName = args[1]
+	} else if wild != nil {
+		opponentName = wild.Name
+	} else {
+		fmt.Println("There is no wild pokemon to battle, use encounter to find one")
+		return nil
+	}
+
+	player, err := newOwnedBattler(ctx, mine)
+	if err != nil {
+		return err
+	}
+	var opponent *game.Battler
+	var wildPokemon pokeapi.PokemonResponse
+	isWild := wild != nil && wild.Name == opponentName
+	if isWild {
+		wildPokemon, err = client.GetPokemonInformation(ctx, wild.Name)
+		if err != nil {
+			return friendlyError(ctx, err, "Pokémon", wild.Name, nil)
+		}
+		if opponent, err = newBattler(ctx, wildPokemon, wild.Level); err != nil {
+			return err
+		}
+		opponent.MaxHP, opponent.HP = wild.MaxHP, wild.CurrentHP
+	} else if len(storage.Find(opponentName)) > 0 {
+		theirs, ok := findOwned(opponentName)
+		if !ok {
+			return nil
+		}
+		if theirs.ID == mine.ID {
+			fmt.Printf("%s can't battle itself\n", mine.Name())
+			return nil
+		}
+		if opponent, err = newOwnedBattler(ctx, theirs); err != nil {
+			return err
+		}
+	} else {
+		fmt.Printf("There is no wild %s here and you have not caught one%s\n", opponentName, didYouMean(opponentName, caughtNames()))
+		return nil
+	}
+
+	chart, err := typeChart(ctx)
+	if err != nil {
+		return err
+	}
+
+	if isWild {
+		fmt.Println("Wild", describeBattler(opponent))
+	} else {
+		fmt.Println("Opponent", describeBattler(opponent))
+	}
+	fmt.Println("Go!", describeBattler(player))
+
+	battle := &game.Battle{A: player, B: opponent, Chart: chart}
+	for !battle.Over() && battle.Turns < MAX_TURNS {
+		if err := ctx.Err(); err != nil {
+			return friendlyError(ctx, err, "", "", nil)
+		}
+		attacks := battle.Turn(rng)
+		fmt.Printf("Turn %d\n", battle.Turns)
+		for _, a := range attacks {
+			fmt.Println("  " + describeAttack(a))
+		}
+	}
+
+	if isWild {
+		wild.CurrentHP = opponent.HP
+	}
+	winner := battle.Winner()
+	switch {
+	case winner == nil:
+		fmt.Println("The battle dragged on for too long, both sides retreat")
+	case winner == player:
+		fmt.Printf("%s fainted! %s wins\n", opponent.Name, player.Name)
+		if isWild {
+			level := wild.Level
+			wild = nil
+			if err := rewardExperience(ctx, mine, wildPokemon, level); err != nil {
+				return err
+			}
+		}
+	default:
+		fmt.Printf("%s fainted! %s wins\n", player.Name, opponent.Name)
+	}
+	if isWild && wild != nil {
+		fmt.Printf("The wild %s has %d/%d HP left. Use catch to throw a ball at it.\n", wild.Name, wild.CurrentHP, wild.MaxHP)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package repl
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var cancelMu sync.Mutex
+var cancelCommand context.CancelFunc
+
+// runCommand runs a command with its own context which is cancelled when
+// the user presses Ctrl-C while the command is running.
+func runCommand(command CliCommand, args []string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cancelMu.Lock()
+	cancelCommand = cancel
+	cancelMu.Unlock()
+
+	defer func() {
+		cancelMu.Lock()
+		cancelCommand = nil
+		cancelMu.Unlock()
+	}()
+
+	return command.callback(ctx, config, args)
+}
+
+// interruptCommand cancels the running command. It reports false when no
+// command is running, i.e. the user is at the prompt.
+func interruptCommand() bool {
+	cancelMu.Lock()
+	defer cancelMu.Unlock()
+
+	if cancelCommand == nil {
+		return false
+	}
+	cancelCommand()
+	return true
+}
+
+// parseArgs separates positional arguments from "--name value" and
+// "--name=value" options.
+func parseArgs(args []string) ([]string, map[string]string) {
+	positional := []string{}
+	options := map[string]string{}
+	for i := 0; i < len(args); i++ {
+		name, ok := strings.CutPrefix(args[i], "--")
+		if !ok {
+			positional = append(positional, args[i])
+			continue
+		}
+		if key, value, ok := strings.Cut(name, "="); ok {
+			options[key] = value
+		} else if i+1 < len(args) && !strings.HasPrefix(args[i+1], "--") {
+			options[name] = args[i+1]
+			i++
+		} else {
+			options[name] = ""
+		}
+	}
+	return positional, options
+}
+
+// checkOptions reports the first option not in allowed, if any.
+func checkOptions(options map[string]string, allowed ...string) error {
+	for name := range options {
+		if !slices.Contains(allowed, name) {
+			return fmt.Errorf("Unknown option '--%s'", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package repl
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/game"
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+// currentArea is the location area the player is in. gameVersion restricts
+// encounters to one game, empty meaning any.
+var currentArea string
+var gameVersion string
+var wild *game.WildPokemon
+
+func encounterMethods(encounters []pokeapi.Encounter) []string {
+	methods := []string{}
+	for _, e := range encounters {
+		if !slices.Contains(methods, e.Method) {
+			methods = append(methods, e.Method)
+		}
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+func commandEncounter(ctx context.Context, c *pokeapi.Config, args []string) error {
+	positional, options := parseArgs(args)
+	if err := checkOptions(options, "version"); err != nil {
+		return err
+	}
+	if currentArea == "" {
+		fmt.Println("You're not in any area yet, use goto to travel to one")
+		return nil
+	}
+	method := ""
+	if len(positional) > 0 {
+		method = positional[0]
+	}
+	version := gameVersion
+	if v, ok := options["version"]; ok {
+		if !resolveVersion(ctx, v) {
+			return nil
+		}
+		version = v
+	}
+
+	area, err := client.GetLocationArea(ctx, currentArea)
+	if err != nil {
+		return friendlyError(ctx, err, "location area", currentArea, areaNames)
+	}
+	available := game.AvailableEncounters(game.FilterEncounters(area.Encounters(), version, ""), time.Now())
+	if len(available) == 0 {
+		fmt.Printf("There are no wild pokemons in %s%s right now\n", currentArea, versionSuffix(version))
+		return nil
+	}
+	if version == "" {
+		// Each version has its own encounter tables, so only use one.
+		version = available[0].Version
+		available = game.FilterEncounters(available, version, "")
+	}
+	candidates := game.FilterEncounters(available, "", method)
+	if len(candidates) == 0 {
+		methods := encounterMethods(available)
+		fmt.Printf("Can't encounter pokemons by '%s' in %s%s\n", method, currentArea, didYouMean(method, methods))
+		fmt.Println("Available methods:", methods)
+		return nil
+	}
+
+	encounter, ok := game.SampleEncounter(rng, candidates)
+	if !ok {
+		fmt.Println("Nothing appeared...")
+		return nil
+	}
+	pokemon, err := client.GetPokemonInformation(ctx, encounter.Name)
+	if err != nil {
+		return friendlyError(ctx, err, "Pokémon", encounter.Name, nil)
+	}
+	encounter.MaxHP = game.MaxHP(pokemon.BaseStat("hp"), encounter.Level)
+	encounter.CurrentHP = rng.IntN(encounter.MaxHP) + 1
+	wild = &encounter
+
+	fmt.Printf("A wild %s (Lv. %d) appeared! [%s, %s]\n", wild.Name, wild.Level, wild.Method, wild.Version)
+	fmt.Printf("HP %d/%d. Use catch to throw a ball at it.\n", wild.CurrentHP, wild.MaxHP)
+	return nil
+}
+
+func versionSuffix(version string) string {
+	if version == "" {
+		return ""
+	}
+	return " in pokemon " + version
+}
+
+// methodRates describes the encounter rate of a method in each version the
+// encounters come from, e.g. "encounter rate: diamond 10%, pearl 10%".
+func methodRates(encounters []pokeapi.Encounter) string {
+	rates := []string{}
+	seen := map[string]bool{}
+	for _, e := range encounters {
+		if seen[e.Version] {
+			continue
+		}
+		seen[e.Version] = true
+		rates = append(rates, fmt.Sprintf("%s %d%%", e.Version, e.MethodRate))
+	}
+	return "encounter rate: " + strings.Join(rates, ", ")
+}
+
+// summarizeEncounters merges the slots of each area, pokemon, method, version
+// and set of conditions, widening the level range and adding up the chances.
+// Slots with different conditions, such as a time of day or a swarm, belong
+// to different encounter tables and stay apart. Rows are ordered by pokemon,
+// keeping the API's version order within each.
+func summarizeEncounters(encounters []pokeapi.Encounter) []pokeapi.Encounter {
+	summary := []pokeapi.Encounter{}
+	index := map[[5]string]int{}
+	for _, e := range encounters {
+		key := [5]string{e.Area, e.Pokemon, e.Method, e.Version, e.Condition()}
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(summary)
+			summary = append(summary, e)
+			continue
+		}
+		summary[i].MinLevel = min(summary[i].MinLevel, e.MinLevel)
+		summary[i].MaxLevel = max(summary[i].MaxLevel, e.MaxLevel)
+		summary[i].Chance += e.Chance
+	}
+	sort.SliceStable(summary, func(i, j int) bool {
+		return summary[i].Pokemon < summary[j].Pokemon
+	})
+	return summary
+}
+
+func conditionColumn(e pokeapi.Encounter) string {
+	if len(e.Conditions) == 0 {
+		return "-"
+	}
+	return e.Condition()
+}
+
+func levelRange(minLevel, maxLevel int) string {
+	if minLevel == maxLevel {
+		return fmt.Sprintf("%d", minLevel)
+	}
+	return fmt.Sprintf("%d-%d", minLevel, maxLevel)
+}
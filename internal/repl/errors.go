@@ -0,0 +1,37 @@
+package repl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+// friendlyError turns errors from the pokeapi client into messages meant
+// for the player. kind and name describe what was being looked up, e.g.
+// "Pokémon" and "pikachuu". When the lookup was not found, candidates (if
+// non-nil) is called to offer the closest names.
+func friendlyError(ctx context.Context, err error, kind, name string, candidates func(context.Context) []string) error {
+	var httpErr *pokeapi.HTTPError
+	var decodeErr *pokeapi.DecodeError
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return errors.New("Request cancelled")
+	case errors.Is(err, pokeapi.ErrNotFound) && name != "":
+		suggestion := ""
+		if candidates != nil {
+			suggestion = didYouMean(name, candidates(ctx))
+		}
+		return fmt.Errorf("No %s named '%s'%s", kind, name, suggestion)
+	case errors.Is(err, pokeapi.ErrNotFound):
+		return fmt.Errorf("Could not find that %s", kind)
+	case errors.As(err, &httpErr):
+		return fmt.Errorf("The PokeAPI server returned %d %s, try again later", httpErr.StatusCode, http.StatusText(httpErr.StatusCode))
+	case errors.As(err, &decodeErr):
+		return fmt.Errorf("Received an unexpected response from the PokeAPI server")
+	}
+	return err
+}
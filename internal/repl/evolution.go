@@ -0,0 +1,243 @@
+package repl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/game"
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+// describeEvolution summarizes what it takes to evolve, e.g.
+// "level 16" or "trade holding metal-coat".
+func describeEvolution(d pokeapi.EvolutionDetail) string {
+	parts := []string{}
+	switch d.Trigger.Name {
+	case "level-up":
+		if d.MinLevel != nil {
+			parts = append(parts, fmt.Sprintf("level %d", *d.MinLevel))
+		} else {
+			parts = append(parts, "level up")
+		}
+	case "use-item":
+		if d.Item != nil {
+			parts = append(parts, "use "+d.Item.Name)
+		} else {
+			parts = append(parts, "use item")
+		}
+	case "trade":
+		parts = append(parts, "trade")
+	default:
+		parts = append(parts, strings.ReplaceAll(d.Trigger.Name, "-", " "))
+	}
+
+	if d.Trigger.Name != "level-up" && d.MinLevel != nil {
+		parts = append(parts, fmt.Sprintf("from level %d", *d.MinLevel))
+	}
+	if d.HeldItem != nil {
+		parts = append(parts, "holding "+d.HeldItem.Name)
+	}
+	if d.TradeSpecies != nil {
+		parts = append(parts, "for "+d.TradeSpecies.Name)
+	}
+	if d.MinHappiness != nil {
+		parts = append(parts, fmt.Sprintf("friendship %d+", *d.MinHappiness))
+	}
+	if d.MinAffection != nil {
+		parts = append(parts, fmt.Sprintf("affection %d+", *d.MinAffection))
+	}
+	if d.MinBeauty != nil {
+		parts = append(parts, fmt.Sprintf("beauty %d+", *d.MinBeauty))
+	}
+	if d.TimeOfDay != "" {
+		parts = append(parts, "during the "+d.TimeOfDay)
+	}
+	if d.KnownMove != nil {
+		parts = append(parts, "knowing "+d.KnownMove.Name)
+	}
+	if d.KnownMoveType != nil {
+		parts = append(parts, "knowing a "+d.KnownMoveType.Name+" move")
+	}
+	if d.Location != nil {
+		parts = append(parts, "at "+d.Location.Name)
+	}
+	if d.PartySpecies != nil {
+		parts = append(parts, "with "+d.PartySpecies.Name+" in the party")
+	}
+	if d.PartyType != nil {
+		parts = append(parts, "with a "+d.PartyType.Name+" type in the party")
+	}
+	if d.Gender != nil {
+		parts = append(parts, map[int]string{1: "female", 2: "male"}[*d.Gender]+" only")
+	}
+	if d.RelativePhysicalStats != nil {
+		parts = append(parts, map[int]string{1: "attack > defense", 0: "attack = defense", -1: "attack < defense"}[*d.RelativePhysicalStats])
+	}
+	if d.NeedsOverworldRain {
+		parts = append(parts, "while raining")
+	}
+	if d.TurnUpsideDown {
+		parts = append(parts, "holding the console upside down")
+	}
+	return strings.Join(parts, ", ")
+}
+
+func describeEvolutions(details []pokeapi.EvolutionDetail) string {
+	descriptions := []string{}
+	for _, d := range details {
+		descriptions = append(descriptions, describeEvolution(d))
+	}
+	return strings.Join(descriptions, " or ")
+}
+
+// renderChain draws the chain as a tree, marking the species named current.
+func renderChain(sb *strings.Builder, link pokeapi.ChainLink, current, prefix string, last, root bool) {
+	name := link.Species.Name
+	if name == current {
+		name = "[" + name + "]"
+	}
+	if len(link.EvolutionDetails) > 0 {
+		name += " (" + describeEvolutions(link.EvolutionDetails) + ")"
+	}
+
+	childPrefix := prefix
+	if root {
+		sb.WriteString(name + "\n")
+	} else if last {
+		sb.WriteString(prefix + "└── " + name + "\n")
+		childPrefix += "    "
+	} else {
+		sb.WriteString(prefix + "├── " + name + "\n")
+		childPrefix += "│   "
+	}
+
+	for i, next := range link.EvolvesTo {
+		renderChain(sb, next, current, childPrefix, i == len(link.EvolvesTo)-1, false)
+	}
+}
+
+func commandEvolutions(ctx context.Context, c *pokeapi.Config, args []string) error {
+	if len(args) == 0 {
+		fmt.Println("No pokemon provided")
+		return nil
+	}
+	name := args[0]
+	pokemon, err := client.GetPokemonInformation(ctx, name)
+	if err != nil {
+		return friendlyError(ctx, err, "Pokémon", name, pokemonNames)
+	}
+	species, err := client.GetSpeciesOf(ctx, pokemon)
+	if err != nil {
+		return friendlyError(ctx, err, "Pokémon species", pokemon.Species.Name, nil)
+	}
+	chain, err := client.GetEvolutionChain(ctx, species)
+	if errors.Is(err, pokeapi.ErrNoEvolutionChain) {
+		fmt.Printf("%s does not evolve\n", name)
+		return nil
+	}
+	if err != nil {
+		return friendlyError(ctx, err, "evolution chain", "", nil)
+	}
+
+	sb := strings.Builder{}
+	renderChain(&sb, chain.Chain, species.Name, "", true, true)
+	fmt.Print(sb.String())
+	return nil
+}
+
+func commandEvolve(ctx context.Context, c *pokeapi.Config, args []string) error {
+	positional, options := parseArgs(args)
+	if err := checkOptions(options, "item", "into"); err != nil {
+		return err
+	}
+	if len(positional) == 0 {
+		fmt.Println("usage: evolve <pokemon> [--item <item>] [--into <pokemon>]")
+		return nil
+	}
+	o, ok := findOwned(positional[0])
+	if !ok {
+		return nil
+	}
+
+	species, err := client.GetSpeciesOf(ctx, o.Pokemon)
+	if err != nil {
+		return friendlyError(ctx, err, "Pokémon species", o.Pokemon.Species.Name, nil)
+	}
+	chain, err := client.GetEvolutionChain(ctx, species)
+	if err != nil && !errors.Is(err, pokeapi.ErrNoEvolutionChain) {
+		return friendlyError(ctx, err, "evolution chain", "", nil)
+	}
+	link, ok := chain.Chain.Find(species.Name)
+	if !ok || len(link.EvolvesTo) == 0 {
+		fmt.Printf("%s does not evolve\n", o.Name())
+		return nil
+	}
+
+	item := ""
+	if name, ok := options["item"]; ok {
+		res, err := client.GetItem(ctx, name)
+		if err != nil {
+			return friendlyError(ctx, err, "item", name, itemNames)
+		}
+		if items[res.Name] <= 0 {
+			fmt.Printf("You don't have any %s\n", res.DisplayName(LANGUAGE))
+			return nil
+		}
+		item = res.Name
+	}
+
+	n, err := nature(ctx, o)
+	if err != nil {
+		return err
+	}
+	evolutions := game.Evolutions(o, link, game.EvolutionContext{
+		Item:  item,
+		Time:  time.Now(),
+		Stats: o.Stats(n),
+	})
+	if into, ok := options["into"]; ok {
+		evolutions = slices.DeleteFunc(evolutions, func(l pokeapi.ChainLink) bool {
+			return l.Species.Name != into
+		})
+	}
+
+	if len(evolutions) == 0 {
+		fmt.Printf("%s can't evolve right now. It evolves into:\n", o.Name())
+		for _, next := range link.EvolvesTo {
+			fmt.Printf("  - %s (%s)\n", next.Species.Name, describeEvolutions(next.EvolutionDetails))
+		}
+		return nil
+	}
+	if len(evolutions) > 1 {
+		names := []string{}
+		for _, next := range evolutions {
+			names = append(names, next.Species.Name)
+		}
+		fmt.Printf("%s can evolve into %s, pick one with --into\n", o.Name(), strings.Join(names, " or "))
+		return nil
+	}
+
+	next, err := client.GetPokemonSpecies(ctx, evolutions[0].Species.Name)
+	if err != nil {
+		return friendlyError(ctx, err, "Pokémon species", evolutions[0].Species.Name, nil)
+	}
+	evolved, err := client.GetPokemonInformation(ctx, next.DefaultVariety())
+	if err != nil {
+		return friendlyError(ctx, err, "Pokémon", next.DefaultVariety(), nil)
+	}
+
+	name, from := o.Name(), o.Pokemon.Name
+	if item != "" {
+		items.Use(item)
+		fmt.Printf("You used a %s on %s.\n", itemName(ctx, item), name)
+	}
+	o.Evolve(evolved)
+	fmt.Printf("What? %s is evolving!\n", name)
+	fmt.Printf("Congratulations! Your %s evolved into %s!\n", from, evolved.Name)
+	autosave()
+	return nil
+}
@@ -1,13 +1,62 @@
 package repl
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 )
 
+// Ctrl-C reaches the REPL as SIGINT rather than as input, so the line being
+// typed is shared with the interrupt handler, which clears it when there is
+// one instead of exiting.
+var (
+	lineMu      sync.Mutex
+	linePrompt  string
+	lineTyped   bool
+	lineCleared bool
+)
+
+// clearLine drops the half-typed line at the prompt. It reports false when
+// nothing has been typed.
+func clearLine() bool {
+	lineMu.Lock()
+	defer lineMu.Unlock()
+
+	if !lineTyped {
+		return false
+	}
+	lineTyped = false
+	lineCleared = true
+	fmt.Print("^C\n" + linePrompt)
+	return true
+}
+
+func markLine(typed bool) {
+	lineMu.Lock()
+	lineTyped = typed
+	lineMu.Unlock()
+}
+
+// takeCleared reports whether the line was cleared since it was last called.
+func takeCleared() bool {
+	lineMu.Lock()
+	defer lineMu.Unlock()
+
+	cleared := lineCleared
+	lineCleared = false
+	return cleared
+}
+
 func readInput(prompt string) (string, bool) {
 	fmt.Print(prompt)
+	lineMu.Lock()
+	linePrompt = prompt
+	lineTyped = false
+	lineCleared = false
+	lineMu.Unlock()
+	defer markLine(false)
 
 	var input []byte
 	historyIndex = len(history)
@@ -23,6 +72,11 @@ func readInput(prompt string) (string, bool) {
 			fmt.Println()
 			return "", false
 		}
+		if takeCleared() {
+			input = input[:0]
+			historyIndex = len(history)
+			modifications = make(map[int]string)
+		}
 
 		switch char[0] {
 		case 10, 13: // Enter
@@ -84,8 +138,8 @@ func readInput(prompt string) (string, bool) {
 				input = input[:len(input)-1]
 				fmt.Print("\b \b")
 			}
-		case 3, 4: // SIGINT, EOF
-			commandExit(config, "")
+		case 4: // EOF
+			commandExit(context.Background(), config, nil)
 
 		default:
 			if char[0] >= 32 && char[0] <= 126 {
@@ -93,5 +147,6 @@ func readInput(prompt string) (string, bool) {
 				fmt.Print(string(char[0]))
 			}
 		}
+		markLine(len(input) > 0)
 	}
 }
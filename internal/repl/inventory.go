@@ -0,0 +1,65 @@
+package repl
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/game"
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+const LANGUAGE = "en"
+
+var inventory game.Inventory = game.StartingInventory()
+var items game.Items = game.StartingItems()
+
+// ballName returns the ball's English name from the item endpoint, or a name
+// derived from its identifier if the item can't be fetched.
+func ballName(ctx context.Context, ball game.Ball) string {
+	item, err := client.GetItem(ctx, string(ball))
+	if err != nil {
+		return strings.ReplaceAll(string(ball), "-", " ")
+	}
+	return item.DisplayName(LANGUAGE)
+}
+
+func ballNames() []string {
+	names := []string{}
+	for _, b := range game.Balls {
+		names = append(names, strings.TrimSuffix(string(b), "-ball"))
+	}
+	return names
+}
+
+func commandInventory(ctx context.Context, c *pokeapi.Config, args []string) error {
+	fmt.Println("Your bag:")
+	for _, ball := range game.Balls {
+		item, err := client.GetItem(ctx, string(ball))
+		if err != nil {
+			fmt.Printf("  - %s x%d\n", ballName(ctx, ball), inventory[ball])
+			continue
+		}
+		fmt.Printf("  - %s x%d: %s\n", item.DisplayName(LANGUAGE), inventory[ball], item.ShortEffect(LANGUAGE))
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(items)) {
+		if items[name] <= 0 {
+			continue
+		}
+		fmt.Printf("  - %s x%d\n", itemName(ctx, name), items[name])
+	}
+	return nil
+}
+
+// itemName returns the item's English name from the item endpoint, or its
+// identifier if the item can't be fetched.
+func itemName(ctx context.Context, name string) string {
+	item, err := client.GetItem(ctx, name)
+	if err != nil {
+		return name
+	}
+	return item.DisplayName(LANGUAGE)
+}
@@ -0,0 +1,146 @@
+package repl
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/game"
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+// In strict mode pokemons can only be caught in an area they live in.
+var strictMode bool
+
+func commandGoto(ctx context.Context, c *pokeapi.Config, args []string) error {
+	if len(args) == 0 {
+		fmt.Println("No location provided")
+		return nil
+	}
+	area, err := client.GetLocationArea(ctx, args[0])
+	if err != nil {
+		return friendlyError(ctx, err, "location area", args[0], areaNames)
+	}
+
+	if currentArea != area.Name {
+		currentArea = area.Name
+		wild = nil
+	}
+	fmt.Printf("You arrived at %s\n", currentArea)
+	return nil
+}
+
+func commandWhereami(ctx context.Context, c *pokeapi.Config, args []string) error {
+	if currentArea == "" {
+		fmt.Println("You're not in any area yet, use goto to travel to one")
+		return nil
+	}
+	fmt.Printf("You're at %s\n", currentArea)
+	return nil
+}
+
+func commandStrict(ctx context.Context, c *pokeapi.Config, args []string) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "on":
+			strictMode = true
+		case "off":
+			strictMode = false
+		default:
+			fmt.Println("Usage: strict [on|off]")
+			return nil
+		}
+	}
+	if strictMode {
+		fmt.Println("Strict mode is on: pokemons can only be caught in areas they live in")
+	} else {
+		fmt.Println("Strict mode is off: any pokemon can be caught anywhere")
+	}
+	return nil
+}
+
+// checkCatchable reports why name can't be caught at the current location
+// in strict mode, or an empty string if it can.
+func checkCatchable(ctx context.Context, name string) (string, error) {
+	if !strictMode {
+		return "", nil
+	}
+	if currentArea == "" {
+		return "You're not in any area yet, use goto to travel to one", nil
+	}
+	area, err := client.GetLocationArea(ctx, currentArea)
+	if err != nil {
+		return "", friendlyError(ctx, err, "location area", currentArea, nil)
+	}
+
+	names := []string{}
+	for _, e := range game.FilterEncounters(area.Encounters(), gameVersion, "") {
+		if !slices.Contains(names, e.Pokemon) {
+			names = append(names, e.Pokemon)
+		}
+	}
+	if !slices.Contains(names, name) {
+		return fmt.Sprintf("There are no %ss in %s%s", name, currentArea, didYouMean(name, names)), nil
+	}
+	return "", nil
+}
+
+func commandWhere(ctx context.Context, c *pokeapi.Config, args []string) error {
+	positional, options := parseArgs(args)
+	if err := checkOptions(options, "version"); err != nil {
+		return err
+	}
+	if len(positional) == 0 {
+		fmt.Println("No pokemon provided")
+		return nil
+	}
+	name := positional[0]
+	version := gameVersion
+	if v, ok := options["version"]; ok {
+		if !resolveVersion(ctx, v) {
+			return nil
+		}
+		version = v
+	}
+
+	pokemon, err := client.GetPokemonInformation(ctx, name)
+	if err != nil {
+		return friendlyError(ctx, err, "Pokémon", name, pokemonNames)
+	}
+	encounters, err := client.GetPokemonEncounters(ctx, pokemon)
+	if err != nil {
+		return friendlyError(ctx, err, "Pokémon", name, nil)
+	}
+	encounters = summarizeEncounters(game.FilterEncounters(encounters, version, ""))
+	if len(encounters) == 0 {
+		fmt.Printf("%s can't be found in the wild%s\n", name, versionSuffix(version))
+		return nil
+	}
+
+	areas := []string{}
+	for _, e := range encounters {
+		if !slices.Contains(areas, e.Area) {
+			areas = append(areas, e.Area)
+		}
+	}
+	for i, area := range areas {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Println(area)
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "  METHOD\tVERSION\tLEVELS\tCHANCE\tCONDITIONS")
+		for _, e := range encounters {
+			if e.Area == area {
+				fmt.Fprintf(w, "  %s\t%s\t%s\t%d%%\t%s\n", e.Method, e.Version, levelRange(e.MinLevel, e.MaxLevel), e.Chance, conditionColumn(e))
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
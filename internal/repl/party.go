@@ -0,0 +1,205 @@
+package repl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/game"
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+var storage *game.Storage = game.NewStorage()
+
+// findOwned looks up one owned pokemon by ID, nickname or species name,
+// telling the player why when there is no single match.
+func findOwned(ref string) (*game.Owned, bool) {
+	matches := storage.Find(ref)
+	switch len(matches) {
+	case 0:
+		fmt.Println("you have not caught that pokemon" + didYouMean(ref, caughtNames()))
+		return nil, false
+	case 1:
+		return matches[0], true
+	}
+	ids := []string{}
+	for _, o := range matches {
+		ids = append(ids, fmt.Sprintf("#%d", o.ID))
+	}
+	fmt.Printf("You have %d pokemons called %s (%s), use the number to pick one\n", len(matches), ref, strings.Join(ids, ", "))
+	return nil, false
+}
+
+func ownedLine(o *game.Owned) string {
+	name := o.Pokemon.Name
+	if o.Nickname != "" {
+		name = fmt.Sprintf("%s (%s)", o.Nickname, o.Pokemon.Name)
+	}
+	return fmt.Sprintf("#%d %s Lv. %d", o.ID, name, o.Level)
+}
+
+// describeCatch says when, where and with what ball o was caught. Pokemons
+// from old saves only know when.
+func describeCatch(ctx context.Context, o *game.Owned) string {
+	description := o.CaughtAt.Format("2006-01-02 15:04")
+	if o.Location != "" {
+		description += " at " + o.Location
+	}
+	if o.Ball != "" {
+		description += " in a " + ballName(ctx, o.Ball)
+	}
+	return description
+}
+
+func placeName(box int) string {
+	if box == 0 {
+		return "your party"
+	}
+	return fmt.Sprintf("box %d", box)
+}
+
+func storageError(err error) error {
+	switch {
+	case errors.Is(err, game.ErrPartyFull):
+		return fmt.Errorf("Your party is full, deposit or swap a pokemon first")
+	case errors.Is(err, game.ErrBoxFull):
+		return fmt.Errorf("That box is full")
+	case errors.Is(err, game.ErrStorageFull):
+		return fmt.Errorf("Your party and boxes are full, release a pokemon first")
+	case errors.Is(err, game.ErrNoBox):
+		return fmt.Errorf("Boxes are numbered 1 to %d", game.BoxCount)
+	case errors.Is(err, game.ErrLastPokemon):
+		return fmt.Errorf("You can't leave your party empty")
+	case errors.Is(err, game.ErrInParty):
+		return fmt.Errorf("That pokemon is already in your party")
+	case errors.Is(err, game.ErrInBox):
+		return fmt.Errorf("That pokemon is already in a box")
+	}
+	return err
+}
+
+func commandParty(ctx context.Context, c *pokeapi.Config, args []string) error {
+	if len(storage.Party) == 0 {
+		fmt.Println("Your party is empty")
+		return nil
+	}
+	fmt.Printf("Your party (%d/%d):\n", len(storage.Party), game.PartySize)
+	for i, o := range storage.Party {
+		fmt.Printf("  %d. %s\n", i+1, ownedLine(o))
+	}
+	return nil
+}
+
+func commandBox(ctx context.Context, c *pokeapi.Config, args []string) error {
+	boxes := []int{}
+	if len(args) > 0 {
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n < 1 || n > game.BoxCount {
+			return storageError(game.ErrNoBox)
+		}
+		boxes = append(boxes, n)
+	} else {
+		for n := 1; n <= game.BoxCount; n++ {
+			if len(storage.Box(n)) > 0 {
+				boxes = append(boxes, n)
+			}
+		}
+		if len(boxes) == 0 {
+			fmt.Println("Your boxes are empty")
+			return nil
+		}
+	}
+
+	for _, n := range boxes {
+		box := storage.Box(n)
+		fmt.Printf("Box %d (%d/%d):\n", n, len(box), game.BoxSize)
+		for _, o := range box {
+			fmt.Println("  -", ownedLine(o))
+		}
+	}
+	return nil
+}
+
+func commandDeposit(ctx context.Context, c *pokeapi.Config, args []string) error {
+	if len(args) == 0 {
+		fmt.Println("usage: deposit <pokemon> [box]")
+		return nil
+	}
+	o, ok := findOwned(args[0])
+	if !ok {
+		return nil
+	}
+	n := 0
+	if len(args) > 1 {
+		var err error
+		if n, err = strconv.Atoi(args[1]); err != nil {
+			return storageError(game.ErrNoBox)
+		}
+	}
+	box, err := storage.Deposit(o.ID, n)
+	if err != nil {
+		return storageError(err)
+	}
+	fmt.Printf("%s was deposited in box %d\n", o.Name(), box)
+	autosave()
+	return nil
+}
+
+func commandWithdraw(ctx context.Context, c *pokeapi.Config, args []string) error {
+	if len(args) == 0 {
+		fmt.Println("usage: withdraw <pokemon>")
+		return nil
+	}
+	o, ok := findOwned(args[0])
+	if !ok {
+		return nil
+	}
+	if err := storage.Withdraw(o.ID); err != nil {
+		return storageError(err)
+	}
+	fmt.Printf("%s joined your party\n", o.Name())
+	autosave()
+	return nil
+}
+
+func commandSwap(ctx context.Context, c *pokeapi.Config, args []string) error {
+	if len(args) < 2 {
+		fmt.Println("usage: swap <pokemon> <pokemon>")
+		return nil
+	}
+	a, ok := findOwned(args[0])
+	if !ok {
+		return nil
+	}
+	b, ok := findOwned(args[1])
+	if !ok {
+		return nil
+	}
+	if err := storage.Swap(a.ID, b.ID); err != nil {
+		return storageError(err)
+	}
+	boxA, _ := storage.Locate(a.ID)
+	boxB, _ := storage.Locate(b.ID)
+	fmt.Printf("%s is now in %s and %s in %s\n", a.Name(), placeName(boxA), b.Name(), placeName(boxB))
+	autosave()
+	return nil
+}
+
+func commandRelease(ctx context.Context, c *pokeapi.Config, args []string) error {
+	if len(args) == 0 {
+		fmt.Println("usage: release <pokemon>")
+		return nil
+	}
+	o, ok := findOwned(args[0])
+	if !ok {
+		return nil
+	}
+	if err := storage.Release(o.ID); err != nil {
+		return storageError(err)
+	}
+	fmt.Printf("%s was released. Bye-bye, %s!\n", ownedLine(o), o.Name())
+	autosave()
+	return nil
+}
@@ -0,0 +1,57 @@
+package repl
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+// All game mechanics draw from rng so that a session started from the same
+// seed replays identically.
+var seed uint64
+var source *rand.PCG
+var rng *rand.Rand
+
+func reseed(s uint64) {
+	seed = s
+	source = rand.NewPCG(s, s)
+	rng = rand.New(source)
+}
+
+// restoreRandom resumes the random sequence of a save. Saves without a
+// stored state start over from their seed.
+func restoreRandom(s uint64, state []byte) {
+	reseed(s)
+	if len(state) > 0 {
+		if err := source.UnmarshalBinary(state); err != nil {
+			reseed(s)
+		}
+	}
+}
+
+func randomState() []byte {
+	state, err := source.MarshalBinary()
+	if err != nil {
+		return nil
+	}
+	return state
+}
+
+func commandSeed(ctx context.Context, c *pokeapi.Config, args []string) error {
+	if len(args) == 0 {
+		fmt.Println("Current seed:", seed)
+		return nil
+	}
+	s, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		fmt.Println("The seed must be a non-negative whole number")
+		return nil
+	}
+	reseed(s)
+	fmt.Println("Random seed set to", seed)
+	autosave()
+	return nil
+}
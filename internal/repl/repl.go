@@ -1,22 +1,29 @@
 package repl
 
 import (
+	"context"
 	"fmt"
 	"math/rand/v2"
 	"os"
 	"os/signal"
+	"sort"
 	"strings"
 	"syscall"
+	"text/tabwriter"
+	"time"
 
+	"github.com/kartikey-tiwari/pokedex-go/internal/game"
 	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
 )
 
+const WILD_LEVEL = 50
+
 func cleanInput(text string) []string {
 	cleanedText := strings.Fields(strings.ToLower(text))
 	return cleanedText
 }
 
-func commandExit(c *pokeapi.Config, arg string) error {
+func commandExit(ctx context.Context, c *pokeapi.Config, args []string) error {
 	fmt.Println("Closing the Pokedex... Goodbye!")
 	if histFile != nil {
 		if len(history) > HIST_SIZE {
@@ -24,12 +31,13 @@ func commandExit(c *pokeapi.Config, arg string) error {
 		}
 		histFile.Close()
 	}
+	client.Close()
 	restoreNormalTTYSettings()
 	os.Exit(0)
 	return nil
 }
 
-func commandHelp(c *pokeapi.Config, arg string) error {
+func commandHelp(ctx context.Context, c *pokeapi.Config, args []string) error {
 	fmt.Println("Welcome to the Pokedex!")
 	fmt.Println("Usage:")
 	fmt.Println()
@@ -39,14 +47,14 @@ func commandHelp(c *pokeapi.Config, arg string) error {
 	return nil
 }
 
-func commandMapMain(c *pokeapi.Config, next bool) error {
+func commandMapMain(ctx context.Context, c *pokeapi.Config, next bool) error {
 	if !next && c.Previous == "" {
 		fmt.Println("You're on the first page")
 		return nil
 	}
-	locations, err := pokeapi.GetLocationAreaNames(c, next)
+	locations, err := client.GetLocationAreaNames(ctx, c, next)
 	if err != nil {
-		return err
+		return friendlyError(ctx, err, "location page", "", nil)
 	}
 
 	for _, area := range locations {
@@ -55,86 +63,215 @@ func commandMapMain(c *pokeapi.Config, next bool) error {
 	return nil
 }
 
-func commandMap(c *pokeapi.Config, arg string) error {
-	return commandMapMain(c, true)
+func commandMap(ctx context.Context, c *pokeapi.Config, args []string) error {
+	return commandMapMain(ctx, c, true)
 }
 
-func commandMapBack(c *pokeapi.Config, arg string) error {
-	return commandMapMain(c, false)
+func commandMapBack(ctx context.Context, c *pokeapi.Config, args []string) error {
+	return commandMapMain(ctx, c, false)
 }
 
-func commandExplore(c *pokeapi.Config, arg string) error {
-	if arg == "" {
+func commandExplore(ctx context.Context, c *pokeapi.Config, args []string) error {
+	positional, options := parseArgs(args)
+	if err := checkOptions(options, "version"); err != nil {
+		return err
+	}
+	area := currentArea
+	if len(positional) > 0 {
+		area = positional[0]
+	}
+	if area == "" {
 		fmt.Println("No location provided")
 		return nil
 	}
-	pokemons, err := pokeapi.GetPokemonsInArea(arg)
+	version := gameVersion
+	if v, ok := options["version"]; ok {
+		if !resolveVersion(ctx, v) {
+			return nil
+		}
+		version = v
+	}
+
+	encounters, err := client.GetPokemonsInArea(ctx, area)
 	if err != nil {
-		return err
+		return friendlyError(ctx, err, "location area", area, areaNames)
+	}
+	encounters = summarizeEncounters(game.FilterEncounters(encounters, version, ""))
+	if len(encounters) == 0 {
+		fmt.Printf("There are no wild pokemons in %s%s\n", area, versionSuffix(version))
+		return nil
 	}
 
-	for _, v := range pokemons {
-		fmt.Println(v)
+	for i, method := range encounterMethods(encounters) {
+		if i > 0 {
+			fmt.Println()
+		}
+		rows := game.FilterEncounters(encounters, "", method)
+		fmt.Printf("%s (%s)\n", method, methodRates(rows))
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "  POKEMON\tVERSION\tLEVELS\tCHANCE\tCONDITIONS")
+		for _, e := range rows {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%d%%\t%s\n", e.Pokemon, e.Version, levelRange(e.MinLevel, e.MaxLevel), e.Chance, conditionColumn(e))
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
-func commandCatch(c *pokeapi.Config, arg string) error {
-	if arg == "" {
+func commandCatch(ctx context.Context, c *pokeapi.Config, args []string) error {
+	positional, options := parseArgs(args)
+	if err := checkOptions(options, "ball"); err != nil {
+		return err
+	}
+	var name string
+	if len(positional) > 0 {
+		name = positional[0]
+	} else if wild != nil {
+		name = wild.Name
+	} else {
 		fmt.Println("No pokemon provided")
 		return nil
 	}
-	fmt.Println("Throwing a Pokeball at " + arg + "...")
-	pokemon, err := pokeapi.GetPokemonInformation(arg)
-	if err != nil {
+
+	ball := game.PokeBall
+	if value, ok := options["ball"]; ok {
+		ball, ok = game.ParseBall(value)
+		if !ok {
+			fmt.Printf("Unknown ball '%s'%s\n", value, didYouMean(value, ballNames()))
+			return nil
+		}
+	}
+	if inventory[ball] <= 0 {
+		fmt.Printf("You don't have any %ss left\n", ballName(ctx, ball))
+		return nil
+	}
+	if reason, err := checkCatchable(ctx, name); err != nil || reason != "" {
+		if reason != "" {
+			fmt.Println(reason)
+		}
 		return err
 	}
-	baseXp := pokemon.BaseExperience
-	caught := rand.IntN(650)+1 > baseXp
-	if caught {
-		pokedex[arg] = pokemon
-		fmt.Println(arg + " was caught!")
-		fmt.Println("You may now inspect it with the inspect command")
+
+	pokemon, err := client.GetPokemonInformation(ctx, name)
+	if err != nil {
+		return friendlyError(ctx, err, "Pokémon", name, pokemonNames)
+	}
+	species, err := client.GetSpeciesOf(ctx, pokemon)
+	if err != nil {
+		return friendlyError(ctx, err, "Pokémon species", pokemon.Species.Name, nil)
+	}
+	growth, err := client.GetGrowthRate(ctx, species.GrowthRate.Name)
+	if err != nil {
+		return friendlyError(ctx, err, "growth rate", species.GrowthRate.Name, nil)
+	}
+
+	inventory.Use(ball)
+	var maxHP, currentHP int
+	level := WILD_LEVEL
+	if wild != nil && wild.Name == name {
+		maxHP, currentHP, level = wild.MaxHP, wild.CurrentHP, wild.Level
+	} else {
+		maxHP = game.MaxHP(pokemon.BaseStat("hp"), level)
+		currentHP = rng.IntN(maxHP) + 1
+	}
+	fmt.Printf("Throwing a %s at %s (HP %d/%d)...\n", ballName(ctx, ball), name, currentHP, maxHP)
+	result := game.Catch(rng, game.CatchAttempt{
+		MaxHP:       maxHP,
+		CurrentHP:   currentHP,
+		CaptureRate: species.CaptureRate,
+		BallBonus:   ball.Bonus(),
+		Status:      game.StatusNone,
+	})
+	for i := 0; i < result.Shakes; i++ {
+		fmt.Println("  ...the ball shakes")
+	}
+	if result.Caught {
+		owned, box, err := storage.Add(game.Owned{
+			Level:      level,
+			Experience: growth.Experience(level),
+			Nature:     game.RandomNature(rng),
+			IVs:        game.RollIVs(rng),
+			EVs:        map[string]int{},
+			Friendship: species.BaseHappiness,
+			CaughtAt:   time.Now(),
+			Location:   currentArea,
+			Ball:       ball,
+			Pokemon:    pokemon,
+		})
+		if err != nil {
+			fmt.Println(name + " was caught, but there is no room left for it, so it was released")
+		} else {
+			if wild != nil && wild.Name == name {
+				wild = nil
+			}
+			fmt.Printf("%s was caught and sent to %s as #%d!\n", name, placeName(box), owned.ID)
+			fmt.Println("You may now inspect it with the inspect command")
+		}
 	} else {
-		fmt.Println(arg + " escaped!")
+		fmt.Println(name + " escaped!")
 	}
+	fmt.Printf("%ss left: %d\n", ballName(ctx, ball), inventory[ball])
+	autosave()
 	return nil
 }
 
-func commandInspect(c *pokeapi.Config, arg string) error {
-	pokemon, ok := pokedex[arg]
+func commandInspect(ctx context.Context, c *pokeapi.Config, args []string) error {
+	if len(args) == 0 {
+		fmt.Println("No pokemon provided")
+		return nil
+	}
+	owned, ok := findOwned(args[0])
 	if !ok {
-		fmt.Println("you have not caught that pokemon")
 		return nil
 	}
+	pokemon := owned.Pokemon
+	box, _ := storage.Locate(owned.ID)
+	fmt.Printf("#%d %s, in %s\n", owned.ID, owned.Name(), placeName(box))
 	fmt.Println("Name:", pokemon.Name)
+	fmt.Println("Caught:", describeCatch(ctx, owned))
 	fmt.Println("Height:", pokemon.Height)
 	fmt.Println("Weight:", pokemon.Weight)
-	fmt.Println("Stats:")
-	for _, v := range pokemon.Stats {
-		fmt.Printf("  -%s: %d\n", v.Stat.Name, v.BaseStat)
+	if err := printOwnedStats(ctx, owned); err != nil {
+		return err
 	}
 	fmt.Println("Types:")
 	for _, v := range pokemon.Types {
 		fmt.Println("  -", v.Type.Name)
 	}
-	return nil
+	return printSpecies(ctx, pokemon)
 }
 
-func commandPokedex(c *pokeapi.Config, arg string) error {
-	if len(pokedex) == 0 {
+func commandPokedex(ctx context.Context, c *pokeapi.Config, args []string) error {
+	if storage.Len() == 0 {
 		fmt.Println("You haven't caught any pokemons yet")
 		return nil
 	}
 
+	counts := map[string]int{}
+	species := []string{}
+	for _, o := range storage.All() {
+		if counts[o.Pokemon.Name] == 0 {
+			species = append(species, o.Pokemon.Name)
+		}
+		counts[o.Pokemon.Name]++
+	}
+	sort.Strings(species)
+
 	fmt.Println("Your Pokedex:")
-	for _, v := range pokedex {
-		fmt.Println(" -", v.Name)
+	for _, name := range species {
+		if counts[name] > 1 {
+			fmt.Printf(" - %s (x%d)\n", name, counts[name])
+		} else {
+			fmt.Println(" -", name)
+		}
 	}
 	return nil
 }
 
-func commandHistory(c *pokeapi.Config, option string) error {
+func commandHistory(ctx context.Context, c *pokeapi.Config, args []string) error {
 	width := len(fmt.Sprintf("%d", len(history)))
 
 	for i, v := range history {
@@ -143,18 +280,18 @@ func commandHistory(c *pokeapi.Config, option string) error {
 	return nil
 }
 
+// CliCommand is a REPL command. Arguments are lowercased unless keepCase
+// is set; the command name always is.
 type CliCommand struct {
 	name        string
 	description string
-	callback    func(conf *pokeapi.Config, arg string) error
+	callback    func(ctx context.Context, conf *pokeapi.Config, args []string) error
+	keepCase    bool
 }
 
 var commandRegistry map[string]CliCommand = make(map[string]CliCommand)
-var config *pokeapi.Config = &pokeapi.Config{
-	Next:     "https://pokeapi.co/api/v2/location-area?limit=20&offset=0",
-	Previous: "",
-}
-var pokedex map[string]pokeapi.PokemonResponse = map[string]pokeapi.PokemonResponse{}
+var client *pokeapi.Client
+var config *pokeapi.Config
 var history []string = []string{}
 var histFile *os.File
 
@@ -181,19 +318,110 @@ func initCommands() {
 	}
 	commandRegistry["explore"] = CliCommand{
 		name:        "explore",
-		description: "Explore a location area",
+		description: "List the pokemons in a location area, or in the current one, optionally --version <game>",
 		callback:    commandExplore,
 	}
 	commandRegistry["catch"] = CliCommand{
 		name:        "catch",
-		description: "Try to catch a pokemon",
+		description: "Try to catch a pokemon (the wild one if none is named), optionally with --ball <poke|great|ultra|master>",
 		callback:    commandCatch,
 	}
+	commandRegistry["goto"] = CliCommand{
+		name:        "goto",
+		description: "Travel to a location area",
+		callback:    commandGoto,
+	}
+	commandRegistry["where"] = CliCommand{
+		name:        "where",
+		description: "List the areas where a pokemon can be found, optionally --version <game>",
+		callback:    commandWhere,
+	}
+	commandRegistry["whereami"] = CliCommand{
+		name:        "whereami",
+		description: "Display the location area you're in",
+		callback:    commandWhereami,
+	}
+	commandRegistry["strict"] = CliCommand{
+		name:        "strict",
+		description: "Show or toggle (on|off) strict mode, where pokemons can only be caught where they live",
+		callback:    commandStrict,
+	}
+	commandRegistry["encounter"] = CliCommand{
+		name:        "encounter",
+		description: "Look for a wild pokemon in the current area, optionally by method (walk, surf, old-rod...)",
+		callback:    commandEncounter,
+	}
+	commandRegistry["version"] = CliCommand{
+		name:        "version",
+		description: "Show or set the game version (red, gold, platinum... or any) used by explore and encounter",
+		callback:    commandVersion,
+	}
 	commandRegistry["inspect"] = CliCommand{
 		name:        "inspect",
-		description: "Display caught pokemon information",
+		description: "Display caught pokemon information and its Pokedex entry",
 		callback:    commandInspect,
 	}
+	commandRegistry["evolutions"] = CliCommand{
+		name:        "evolutions",
+		description: "Display a pokemon's evolution chain",
+		callback:    commandEvolutions,
+	}
+	commandRegistry["weak"] = CliCommand{
+		name:        "weak",
+		description: "Display which types are strong or weak against a pokemon",
+		callback:    commandWeak,
+	}
+	commandRegistry["matchup"] = CliCommand{
+		name:        "matchup",
+		description: "Display how effective an attacking type is against a pokemon",
+		callback:    commandMatchup,
+	}
+	commandRegistry["battle"] = CliCommand{
+		name:        "battle",
+		description: "Battle one of your pokemons against the wild pokemon or another of yours",
+		callback:    commandBattle,
+	}
+	commandRegistry["party"] = CliCommand{
+		name:        "party",
+		description: "List the pokemons in your party",
+		callback:    commandParty,
+	}
+	commandRegistry["box"] = CliCommand{
+		name:        "box",
+		description: "List the pokemons in your PC boxes, or in box n",
+		callback:    commandBox,
+	}
+	commandRegistry["deposit"] = CliCommand{
+		name:        "deposit",
+		description: "Move a pokemon from your party to a PC box",
+		callback:    commandDeposit,
+	}
+	commandRegistry["withdraw"] = CliCommand{
+		name:        "withdraw",
+		description: "Move a pokemon from a PC box to your party",
+		callback:    commandWithdraw,
+	}
+	commandRegistry["swap"] = CliCommand{
+		name:        "swap",
+		description: "Swap the places of two of your pokemons",
+		callback:    commandSwap,
+	}
+	commandRegistry["release"] = CliCommand{
+		name:        "release",
+		description: "Release one of your pokemons",
+		callback:    commandRelease,
+	}
+	commandRegistry["rename"] = CliCommand{
+		name:        "rename",
+		description: "Give one of your pokemons a nickname",
+		callback:    commandRename,
+		keepCase:    true,
+	}
+	commandRegistry["evolve"] = CliCommand{
+		name:        "evolve",
+		description: "Evolve one of your pokemons, optionally using an item from your bag with --item <item>",
+		callback:    commandEvolve,
+	}
 	commandRegistry["pokedex"] = CliCommand{
 		name:        "pokedex",
 		description: "Display names of caught pokemons",
@@ -204,26 +432,73 @@ func initCommands() {
 		description: "Displays previous commands",
 		callback:    commandHistory,
 	}
+	commandRegistry["inventory"] = CliCommand{
+		name:        "inventory",
+		description: "Display the balls in your bag",
+		callback:    commandInventory,
+	}
+	commandRegistry["seed"] = CliCommand{
+		name:        "seed",
+		description: "Show the random seed, or restart the random sequence from a new one",
+		callback:    commandSeed,
+	}
+	commandRegistry["save"] = CliCommand{
+		name:        "save",
+		description: "Save caught pokemons to the current or given slot",
+		callback:    commandSave,
+	}
+	commandRegistry["load"] = CliCommand{
+		name:        "load",
+		description: "Load caught pokemons from a save slot",
+		callback:    commandLoad,
+	}
+	commandRegistry["saves"] = CliCommand{
+		name:        "saves",
+		description: "List save slots",
+		callback:    commandSaves,
+	}
+}
+
+// Options configures a REPL session.
+// Seed, when set, overrides the seed stored in the loaded save. Strict
+// starts the session in strict mode.
+type Options struct {
+	Client  *pokeapi.Client
+	SaveDir string
+	Seed    *uint64
+	Strict  bool
 }
 
-func StartREPL() {
+func StartREPL(opts Options) {
+	client = opts.Client
+	config = client.NewConfig()
+	strictMode = opts.Strict
+
 	defer restoreNormalTTYSettings()
 	enableRawMode()
 	initCommands()
 	loadHistory()
+	reseed(rand.Uint64())
+	openSaves(opts.SaveDir)
+	if opts.Seed != nil {
+		reseed(*opts.Seed)
+	}
 
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
 
 	go func() {
-		<-sigs
-		commandExit(config, "")
+		for range sigs {
+			if !interruptCommand() && !clearLine() {
+				commandExit(context.Background(), config, nil)
+			}
+		}
 	}()
 
 	for {
 		input, ok := readInput("Pokedex > ")
 		if !ok {
-			commandExit(config, "")
+			commandExit(context.Background(), config, nil)
 		}
 
 		trimmedInput := strings.TrimSpace(input)
@@ -238,17 +513,16 @@ func StartREPL() {
 		}
 
 		command, ok := commandRegistry[cleanedInput[0]]
-		var option string
-		if len(cleanedInput) == 2 {
-			option = cleanedInput[1]
-		} else {
-			option = ""
-		}
 		if !ok {
-			fmt.Println("Unknown command. Type help to see list of commands.")
+			fmt.Printf("Unknown command '%s'%s\n", cleanedInput[0], didYouMean(cleanedInput[0], commandNames()))
+			fmt.Println("Type help to see list of commands.")
 			continue
 		} else {
-			err := command.callback(config, option)
+			args := cleanedInput[1:]
+			if command.keepCase {
+				args = strings.Fields(input)[1:]
+			}
+			err := runCommand(command, args)
 			if err != nil {
 				fmt.Println(err)
 			}
@@ -0,0 +1,192 @@
+package repl
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+	"github.com/kartikey-tiwari/pokedex-go/internal/save"
+)
+
+func TestCleanInput(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected []string
+	}{
+		{
+			input:    "  hello world  ",
+			expected: []string{"hello", "world"},
+		},
+		{
+			input:    "Go Is tHe bEsT",
+			expected: []string{"go", "is", "the", "best"},
+		},
+		{
+			input:    "ComPiled   Languages Go  C   c++ 	Rust",
+			expected: []string{"compiled", "languages", "go", "c", "c++", "rust"},
+		},
+		{
+			input:    " 10 20 30 apples	",
+			expected: []string{"10", "20", "30", "apples"},
+		},
+	}
+
+	for _, c := range cases {
+		actual := cleanInput(c.input)
+
+		if len(actual) != len(c.expected) {
+			t.Errorf("output length doesn't match expected length")
+		}
+
+		for i := range actual {
+			word := actual[i]
+			expectedWord := c.expected[i]
+
+			if word != expectedWord {
+				t.Errorf("Output doesn't match expectation")
+			}
+		}
+	}
+}
+
+func TestParseArgs(t *testing.T) {
+	positional, options := parseArgs([]string{"pikachu", "--ball", "great", "--version=red", "--strict"})
+
+	if len(positional) != 1 || positional[0] != "pikachu" {
+		t.Errorf("unexpected positional arguments: %v", positional)
+	}
+	if options["ball"] != "great" || options["version"] != "red" {
+		t.Errorf("unexpected options: %v", options)
+	}
+	if value, ok := options["strict"]; !ok || value != "" {
+		t.Errorf("expected --strict to be set without a value")
+	}
+	if err := checkOptions(options, "ball", "version"); err == nil {
+		t.Errorf("expected --strict to be rejected")
+	}
+}
+
+func TestSummarizeEncounters(t *testing.T) {
+	summary := summarizeEncounters([]pokeapi.Encounter{
+		{Pokemon: "tentacool", Version: "platinum", Method: "surf", Chance: 60, MinLevel: 20, MaxLevel: 30},
+		{Pokemon: "tentacool", Version: "platinum", Method: "surf", Chance: 30, MinLevel: 10, MaxLevel: 20},
+		{Pokemon: "tentacool", Version: "platinum", Method: "old-rod", Chance: 5, MinLevel: 3, MaxLevel: 3},
+		{Pokemon: "tentacool", Version: "platinum", Method: "surf", Chance: 40, MinLevel: 25, MaxLevel: 35, Conditions: []string{"time-night"}},
+	})
+
+	if len(summary) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(summary))
+	}
+	if night := summary[2]; night.Chance != 40 || night.Condition() != "time-night" {
+		t.Errorf("expected the night slot to stay apart, got %+v", night)
+	}
+	surf := summary[0]
+	if surf.Chance != 90 || surf.MinLevel != 10 || surf.MaxLevel != 30 {
+		t.Errorf("unexpected surf summary: %+v", surf)
+	}
+	if levelRange(surf.MinLevel, surf.MaxLevel) != "10-30" || levelRange(3, 3) != "3" {
+		t.Errorf("unexpected level range formatting")
+	}
+}
+
+func TestGenderRatio(t *testing.T) {
+	cases := map[int]string{
+		-1: "genderless",
+		0:  "100% male, 0% female",
+		1:  "87.5% male, 12.5% female",
+		4:  "50% male, 50% female",
+		8:  "0% male, 100% female",
+	}
+	for rate, expected := range cases {
+		if actual := genderRatio(rate); actual != expected {
+			t.Errorf("genderRatio(%d) = %q, expected %q", rate, actual, expected)
+		}
+	}
+}
+
+func TestRenderChain(t *testing.T) {
+	level := 20
+	happiness := 220
+	chain := pokeapi.ChainLink{
+		Species: pokeapi.NamedResource{Name: "pichu"},
+		EvolvesTo: []pokeapi.ChainLink{{
+			Species: pokeapi.NamedResource{Name: "pikachu"},
+			EvolutionDetails: []pokeapi.EvolutionDetail{
+				{Trigger: pokeapi.NamedResource{Name: "level-up"}, MinHappiness: &happiness},
+			},
+			EvolvesTo: []pokeapi.ChainLink{{
+				Species: pokeapi.NamedResource{Name: "raichu"},
+				EvolutionDetails: []pokeapi.EvolutionDetail{
+					{Trigger: pokeapi.NamedResource{Name: "use-item"}, Item: &pokeapi.NamedResource{Name: "thunder-stone"}},
+				},
+			}, {
+				Species: pokeapi.NamedResource{Name: "made-up"},
+				EvolutionDetails: []pokeapi.EvolutionDetail{
+					{Trigger: pokeapi.NamedResource{Name: "level-up"}, MinLevel: &level, TimeOfDay: "night"},
+				},
+			}},
+		}},
+	}
+
+	sb := strings.Builder{}
+	renderChain(&sb, chain, "pikachu", "", true, true)
+	expected := "pichu\n" +
+		"└── [pikachu] (level up, friendship 220+)\n" +
+		"    ├── raichu (use thunder-stone)\n" +
+		"    └── made-up (level 20, during the night)\n"
+	if sb.String() != expected {
+		t.Errorf("unexpected tree:\n%s\nexpected:\n%s", sb.String(), expected)
+	}
+
+	if _, ok := chain.Find("raichu"); !ok {
+		t.Errorf("expected to find raichu in the chain")
+	}
+}
+
+func TestAutosaveKeepsBrokenSlot(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, save.DefaultSlot+".json")
+	broken := []byte(`{"version":999}`)
+	os.WriteFile(path, broken, 0644)
+
+	reseed(1)
+	defer func() { saves, currentSlot, autosavePaused = nil, save.DefaultSlot, false }()
+	openSaves(dir)
+	autosave()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(data, broken) {
+		t.Errorf("expected autosave to leave the slot untouched, got %s", data)
+	}
+
+	if err := saveSlot("other"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if autosavePaused {
+		t.Errorf("expected an explicit save to turn autosave back on")
+	}
+}
+
+func TestClearLine(t *testing.T) {
+	markLine(false)
+	if clearLine() {
+		t.Errorf("expected nothing to clear at an empty prompt")
+	}
+
+	markLine(true)
+	if !clearLine() {
+		t.Errorf("expected a half-typed line to be cleared")
+	}
+	if !takeCleared() {
+		t.Errorf("expected readInput to be told the line was cleared")
+	}
+	if takeCleared() || clearLine() {
+		t.Errorf("expected the line to be cleared only once")
+	}
+}
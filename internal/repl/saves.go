@@ -0,0 +1,143 @@
+package repl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+	"github.com/kartikey-tiwari/pokedex-go/internal/save"
+)
+
+var saves *save.Store
+var currentSlot string = save.DefaultSlot
+
+// autosavePaused is set when the current slot could not be loaded, so a
+// broken or newer save isn't overwritten by an empty session. An explicit
+// save or load turns autosave back on.
+var autosavePaused bool
+
+func openSaves(dir string) {
+	if dir == "" {
+		return
+	}
+	store, err := save.NewStore(dir)
+	if err != nil {
+		fmt.Println("Saving is disabled:", err)
+		return
+	}
+	saves = store
+
+	if err := loadSlot(currentSlot); err != nil && !errors.Is(err, save.ErrNoSave) {
+		autosavePaused = true
+		fmt.Printf("Could not load your Pokedex from slot '%s': %v\n", currentSlot, err)
+		fmt.Println("Autosave is off so the slot is left untouched. Use save <slot> to save this session or load <slot> to switch slots.")
+	}
+}
+
+func loadSlot(slot string) error {
+	f, err := saves.Load(slot)
+	if err != nil {
+		return err
+	}
+	storage = f.Storage
+	inventory = f.Inventory
+	items = f.Items
+	restoreRandom(f.Seed, f.RNGState)
+	currentSlot = slot
+	autosavePaused = false
+	return nil
+}
+
+func saveSlot(slot string) error {
+	if saves == nil {
+		return errors.New("Saving is not available")
+	}
+	f := &save.File{
+		Storage:   storage,
+		Inventory: inventory,
+		Items:     items,
+		Seed:      seed,
+		RNGState:  randomState(),
+	}
+	if err := saves.Save(slot, f); err != nil {
+		return err
+	}
+	currentSlot = slot
+	autosavePaused = false
+	return nil
+}
+
+func autosave() {
+	if saves == nil || autosavePaused {
+		return
+	}
+	if err := saveSlot(currentSlot); err != nil {
+		fmt.Println("Autosave failed:", err)
+	}
+}
+
+func commandSave(ctx context.Context, c *pokeapi.Config, args []string) error {
+	slot := currentSlot
+	if len(args) > 0 {
+		slot = args[0]
+	}
+	if !save.ValidSlot(slot) {
+		fmt.Println("Slot names may only contain letters, digits, '-' and '_'")
+		return nil
+	}
+	if err := saveSlot(slot); err != nil {
+		return err
+	}
+	fmt.Printf("Saved your Pokedex to slot '%s'\n", slot)
+	return nil
+}
+
+func commandLoad(ctx context.Context, c *pokeapi.Config, args []string) error {
+	if saves == nil {
+		return errors.New("Saving is not available")
+	}
+	if len(args) == 0 {
+		fmt.Println("No slot provided")
+		return nil
+	}
+	slot := args[0]
+	err := loadSlot(slot)
+	if errors.Is(err, save.ErrNoSave) {
+		slots, _ := saves.List()
+		names := []string{}
+		for _, s := range slots {
+			names = append(names, s.Name)
+		}
+		fmt.Printf("No save slot named '%s'%s\n", slot, didYouMean(slot, names))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Loaded slot '%s' with %d caught pokemons\n", slot, storage.Len())
+	return nil
+}
+
+func commandSaves(ctx context.Context, c *pokeapi.Config, args []string) error {
+	if saves == nil {
+		return errors.New("Saving is not available")
+	}
+	slots, err := saves.List()
+	if err != nil {
+		return err
+	}
+	if len(slots) == 0 {
+		fmt.Println("No saves yet")
+		return nil
+	}
+
+	for _, s := range slots {
+		marker := " "
+		if s.Name == currentSlot {
+			marker = "*"
+		}
+		fmt.Printf("%s %s: %d caught, saved %s\n", marker, s.Name, s.Caught, s.SavedAt.Format("2006-01-02 15:04"))
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package repl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+func printSpecies(ctx context.Context, pokemon pokeapi.PokemonResponse) error {
+	species, err := client.GetSpeciesOf(ctx, pokemon)
+	if err != nil {
+		return friendlyError(ctx, err, "Pokémon species", pokemon.Species.Name, nil)
+	}
+
+	if genus := species.Genus(LANGUAGE); genus != "" {
+		fmt.Println("Genus:", genus)
+	}
+	if text, version := species.FlavorText(LANGUAGE, gameVersion); text != "" {
+		fmt.Printf("Pokedex entry (%s): %s\n", version, text)
+	}
+	habitat := "unknown"
+	if species.Habitat != nil {
+		habitat = species.Habitat.Name
+	}
+	fmt.Println("Habitat:", habitat)
+	fmt.Println("Color:", species.Color.Name)
+	fmt.Println("Generation:", species.Generation.Name)
+	fmt.Println("Legendary:", yesNo(species.IsLegendary))
+	fmt.Println("Mythical:", yesNo(species.IsMythical))
+
+	eggGroups := []string{}
+	for _, g := range species.EggGroups {
+		eggGroups = append(eggGroups, g.Name)
+	}
+	fmt.Println("Egg groups:", strings.Join(eggGroups, ", "))
+	fmt.Println("Gender ratio:", genderRatio(species.GenderRate))
+	return nil
+}
+
+// genderRatio describes a species' gender_rate, the chance of being female
+// in eighths, with -1 meaning genderless.
+func genderRatio(rate int) string {
+	if rate < 0 {
+		return "genderless"
+	}
+	female := float64(rate) / 8 * 100
+	return fmt.Sprintf("%g%% male, %g%% female", 100-female, female)
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
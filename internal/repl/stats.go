@@ -0,0 +1,149 @@
+package repl
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/game"
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+const MAX_NICKNAME_LENGTH = 12
+
+func growthRate(ctx context.Context, pokemon pokeapi.PokemonResponse) (pokeapi.GrowthRateResponse, error) {
+	species, err := client.GetSpeciesOf(ctx, pokemon)
+	if err != nil {
+		return pokeapi.GrowthRateResponse{}, friendlyError(ctx, err, "Pokémon species", pokemon.Species.Name, nil)
+	}
+	growth, err := client.GetGrowthRate(ctx, species.GrowthRate.Name)
+	if err != nil {
+		return pokeapi.GrowthRateResponse{}, friendlyError(ctx, err, "growth rate", species.GrowthRate.Name, nil)
+	}
+	return growth, nil
+}
+
+func nature(ctx context.Context, o *game.Owned) (pokeapi.NatureResponse, error) {
+	if o.Nature == "" {
+		return pokeapi.NatureResponse{}, nil
+	}
+	n, err := client.GetNature(ctx, o.Nature)
+	if err != nil {
+		return pokeapi.NatureResponse{}, friendlyError(ctx, err, "nature", o.Nature, nil)
+	}
+	return n, nil
+}
+
+func describeNature(n pokeapi.NatureResponse) string {
+	if n.IncreasedStat == nil || n.DecreasedStat == nil || n.IncreasedStat.Name == n.DecreasedStat.Name {
+		return n.Name
+	}
+	return fmt.Sprintf("%s (+%s, -%s)", n.Name, n.IncreasedStat.Name, n.DecreasedStat.Name)
+}
+
+// printOwnedStats shows an owned pokemon's level, experience, nature and
+// actual stats.
+func printOwnedStats(ctx context.Context, o *game.Owned) error {
+	growth, err := growthRate(ctx, o.Pokemon)
+	if err != nil {
+		return err
+	}
+	n, err := nature(ctx, o)
+	if err != nil {
+		return err
+	}
+	o.SyncExperience(growth)
+
+	fmt.Println("Level:", o.Level)
+	if o.Level < game.MaxLevel {
+		fmt.Printf("EXP: %d (%d to the next level)\n", o.Experience, growth.Experience(o.Level+1)-o.Experience)
+	} else {
+		fmt.Println("EXP:", o.Experience)
+	}
+	if n.Name != "" {
+		fmt.Println("Nature:", describeNature(n))
+	}
+	fmt.Println("Friendship:", o.Friendship)
+	fmt.Println("Stats:")
+	stats := o.Stats(n)
+	for _, stat := range game.StatNames {
+		fmt.Printf("  -%s: %d (base %d, IV %d, EV %d)\n", stat, stats[stat], o.Pokemon.BaseStat(stat), o.IVs[stat], o.EVs[stat])
+	}
+	return nil
+}
+
+// rewardExperience gives o the experience and effort values for defeating
+// a wild pokemon at level.
+func rewardExperience(ctx context.Context, o *game.Owned, defeated pokeapi.PokemonResponse, level int) error {
+	growth, err := growthRate(ctx, o.Pokemon)
+	if err != nil {
+		return err
+	}
+	exp := game.ExperienceYield(defeated.BaseExperience, level)
+	gained := o.GainExperience(exp, growth)
+	o.GainEffort(defeated)
+
+	fmt.Printf("%s gained %d EXP\n", o.Name(), exp)
+	if gained > 0 {
+		fmt.Printf("%s grew to Lv. %d!\n", o.Name(), o.Level)
+	}
+	autosave()
+	return nil
+}
+
+// nicknameTaken explains why nickname can't be given to o, or returns an
+// empty string when it can. Nicknames must not shadow a species or another
+// pokemon's nickname, so every pokemon stays reachable by name.
+func nicknameTaken(ctx context.Context, o *game.Owned, nickname string) string {
+	for _, other := range storage.All() {
+		if other != o && strings.EqualFold(other.Nickname, nickname) {
+			return fmt.Sprintf("#%d is already called %s", other.ID, other.Nickname)
+		}
+		if strings.EqualFold(other.Pokemon.Name, nickname) {
+			return fmt.Sprintf("%s is the name of a species", nickname)
+		}
+	}
+	for _, name := range pokemonNames(ctx) {
+		if strings.EqualFold(name, nickname) {
+			return fmt.Sprintf("%s is the name of a species", nickname)
+		}
+	}
+	return ""
+}
+
+func commandRename(ctx context.Context, c *pokeapi.Config, args []string) error {
+	if len(args) < 2 {
+		fmt.Println("usage: rename <pokemon> <nickname>")
+		return nil
+	}
+	o, ok := findOwned(args[0])
+	if !ok {
+		return nil
+	}
+	if len(args) > 2 {
+		fmt.Println("Nicknames can't contain spaces")
+		return nil
+	}
+	nickname := args[1]
+	if _, err := strconv.Atoi(strings.TrimPrefix(nickname, "#")); err == nil {
+		fmt.Println("Nicknames can't be numbers")
+		return nil
+	}
+	if len([]rune(nickname)) > MAX_NICKNAME_LENGTH {
+		fmt.Printf("Nicknames can be at most %d characters long\n", MAX_NICKNAME_LENGTH)
+		return nil
+	}
+
+	old := o.Name()
+	if strings.EqualFold(nickname, o.Pokemon.Name) {
+		nickname = ""
+	} else if reason := nicknameTaken(ctx, o, nickname); reason != "" {
+		fmt.Println(reason)
+		return nil
+	}
+	o.Nickname = nickname
+	fmt.Printf("%s is now known as %s\n", old, o.Name())
+	autosave()
+	return nil
+}
@@ -0,0 +1,84 @@
+package repl
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/suggest"
+)
+
+const MAX_SUGGESTIONS = 3
+
+var pokemonIndex []string
+var areaIndex []string
+var itemIndex []string
+
+func pokemonNames(ctx context.Context) []string {
+	if pokemonIndex == nil {
+		names, err := client.GetAllPokemonNames(ctx)
+		if err != nil {
+			return nil
+		}
+		pokemonIndex = names
+	}
+	return pokemonIndex
+}
+
+func areaNames(ctx context.Context) []string {
+	if areaIndex == nil {
+		names, err := client.GetAllLocationAreaNames(ctx)
+		if err != nil {
+			return nil
+		}
+		areaIndex = names
+	}
+	return areaIndex
+}
+
+func itemNames(ctx context.Context) []string {
+	if itemIndex == nil {
+		names, err := client.GetAllItemNames(ctx)
+		if err != nil {
+			return nil
+		}
+		itemIndex = names
+	}
+	return itemIndex
+}
+
+func commandNames() []string {
+	names := []string{}
+	for name := range commandRegistry {
+		names = append(names, name)
+	}
+	return names
+}
+
+func caughtNames() []string {
+	names := []string{}
+	for _, o := range storage.All() {
+		names = append(names, o.Pokemon.Name)
+		if o.Nickname != "" {
+			names = append(names, o.Nickname)
+		}
+	}
+	return names
+}
+
+// didYouMean returns a " — did you mean ...?" suffix listing the candidates
+// closest to word, or an empty string when nothing is close enough.
+func didYouMean(word string, candidates []string) string {
+	matches := suggest.Closest(word, candidates, MAX_SUGGESTIONS)
+	if len(matches) == 0 {
+		return ""
+	}
+
+	quoted := []string{}
+	for _, m := range matches {
+		quoted = append(quoted, "'"+m+"'")
+	}
+	if len(quoted) == 1 {
+		return " — did you mean " + quoted[0] + "?"
+	}
+	return " — did you mean " + strings.Join(quoted[:len(quoted)-1], ", ") + " or " + quoted[len(quoted)-1] + "?"
+}
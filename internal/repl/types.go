@@ -0,0 +1,104 @@
+package repl
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+// lookupPokemon returns a caught Pokémon, or fetches it when it has not
+// been caught.
+func lookupPokemon(ctx context.Context, name string) (pokeapi.PokemonResponse, error) {
+	if owned := storage.Find(name); len(owned) > 0 {
+		return owned[0].Pokemon, nil
+	}
+	pokemon, err := client.GetPokemonInformation(ctx, name)
+	if err != nil {
+		return pokeapi.PokemonResponse{}, friendlyError(ctx, err, "Pokémon", name, pokemonNames)
+	}
+	return pokemon, nil
+}
+
+func typeChart(ctx context.Context) (pokeapi.TypeChart, error) {
+	chart, err := client.GetTypeChart(ctx)
+	if err != nil {
+		return nil, friendlyError(ctx, err, "type", "", nil)
+	}
+	return chart, nil
+}
+
+func multiplier(m float64) string {
+	return strconv.FormatFloat(m, 'f', -1, 64) + "x"
+}
+
+func commandWeak(ctx context.Context, c *pokeapi.Config, args []string) error {
+	if len(args) == 0 {
+		fmt.Println("No pokemon provided")
+		return nil
+	}
+	pokemon, err := lookupPokemon(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	chart, err := typeChart(ctx)
+	if err != nil {
+		return err
+	}
+
+	defending := pokemon.TypesOf()
+	byMultiplier := map[float64][]string{}
+	for _, attacking := range pokeapi.TypeNames {
+		m := chart.Effectiveness(attacking, defending...)
+		byMultiplier[m] = append(byMultiplier[m], attacking)
+	}
+
+	fmt.Printf("%s (%s) takes:\n", pokemon.Name, strings.Join(defending, "/"))
+	for _, m := range []float64{4, 2, 0.5, 0.25, 0} {
+		if len(byMultiplier[m]) == 0 {
+			continue
+		}
+		fmt.Printf("  %5s from %s\n", multiplier(m), strings.Join(byMultiplier[m], ", "))
+	}
+	return nil
+}
+
+func commandMatchup(ctx context.Context, c *pokeapi.Config, args []string) error {
+	if len(args) < 2 {
+		fmt.Println("usage: matchup <attacking-type> <pokemon>")
+		return nil
+	}
+	attacking := args[0]
+	if !slices.Contains(pokeapi.TypeNames, attacking) {
+		fmt.Printf("No type named '%s'%s\n", attacking, didYouMean(attacking, pokeapi.TypeNames))
+		return nil
+	}
+	pokemon, err := lookupPokemon(ctx, args[1])
+	if err != nil {
+		return err
+	}
+	chart, err := typeChart(ctx)
+	if err != nil {
+		return err
+	}
+
+	defending := pokemon.TypesOf()
+	m := chart.Effectiveness(attacking, defending...)
+	fmt.Printf("%s against %s (%s): %s%s\n", attacking, pokemon.Name, strings.Join(defending, "/"), multiplier(m), effectivenessNote(m))
+	return nil
+}
+
+func effectivenessNote(m float64) string {
+	switch {
+	case m == 0:
+		return " — it doesn't affect it"
+	case m > 1:
+		return " — it's super effective!"
+	case m < 1:
+		return " — it's not very effective..."
+	}
+	return ""
+}
@@ -0,0 +1,53 @@
+package repl
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+var versionIndex []string
+
+func versionNames(ctx context.Context) []string {
+	if versionIndex == nil {
+		names, err := client.GetAllVersionNames(ctx)
+		if err != nil {
+			return nil
+		}
+		versionIndex = names
+	}
+	return versionIndex
+}
+
+// resolveVersion checks that version is a known game version. It prints why
+// not and returns false otherwise.
+func resolveVersion(ctx context.Context, version string) bool {
+	names := versionNames(ctx)
+	if names == nil || slices.Contains(names, version) {
+		return true
+	}
+	fmt.Printf("No game version named '%s'%s\n", version, didYouMean(version, names))
+	return false
+}
+
+func commandVersion(ctx context.Context, c *pokeapi.Config, args []string) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "any", "all":
+			gameVersion = ""
+		default:
+			if !resolveVersion(ctx, args[0]) {
+				return nil
+			}
+			gameVersion = args[0]
+		}
+	}
+	if gameVersion == "" {
+		fmt.Println("Showing encounters from every game version")
+	} else {
+		fmt.Printf("Showing encounters from pokemon %s\n", gameVersion)
+	}
+	return nil
+}
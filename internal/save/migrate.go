@@ -0,0 +1,250 @@
+package save
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math/rand/v2"
+	"sort"
+	"strconv"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/game"
+)
+
+// migrations[n] upgrades a save from version n to n+1. Saves are decoded
+// into a generic map first so a migration can rename, fill in or reshape
+// fields before the result is decoded into File.
+var migrations = map[int]func(raw map[string]any) error{
+	1: addInventory,
+	2: addSeed,
+	3: moveToStorage,
+	4: addIndividuality,
+	5: addFriendship,
+	6: addItems,
+}
+
+// legacyLevel is the level every Pokémon was caught at before owned
+// Pokémon had levels of their own.
+const legacyLevel = 50
+
+// legacyFriendship is the base friendship of most species, given to Pokémon
+// caught before friendship was tracked.
+const legacyFriendship = 70
+
+// addInventory gives saves from before the ball inventory existed the
+// starting set of balls.
+func addInventory(raw map[string]any) error {
+	if _, ok := raw["inventory"]; !ok {
+		raw["inventory"] = game.StartingInventory()
+	}
+	return nil
+}
+
+// addSeed gives saves from before seeded randomness a seed of their own.
+// The seed is a hash of the save's contents, so an old save that is loaded
+// again without being written back gets the same seed every time.
+func addSeed(raw map[string]any) error {
+	if _, ok := raw["seed"]; ok {
+		return nil
+	}
+	contents, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	h := fnv.New64a()
+	h.Write(contents)
+	raw["seed"] = h.Sum64()
+	return nil
+}
+
+// moveToStorage turns the flat pokedex map, which kept one Pokémon per
+// species, into owned Pokémon with their own IDs. The first six, in name
+// order, make up the party and the rest go to the boxes.
+func moveToStorage(raw map[string]any) error {
+	pokedex, _ := raw["pokedex"].(map[string]any)
+	names := []string{}
+	for name := range pokedex {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if len(names) > game.PartySize+game.BoxCount*game.BoxSize {
+		return fmt.Errorf("%d pokemon do not fit in the party and boxes", len(names))
+	}
+
+	party := []any{}
+	boxes := []any{}
+	for i, name := range names {
+		owned := map[string]any{
+			"id":      i + 1,
+			"level":   legacyLevel,
+			"pokemon": pokedex[name],
+		}
+		if savedAt, ok := raw["saved_at"]; ok {
+			owned["caught_at"] = savedAt
+		}
+
+		switch {
+		case len(party) < game.PartySize:
+			party = append(party, owned)
+		case len(boxes) == 0 || len(boxes[len(boxes)-1].([]any)) >= game.BoxSize:
+			boxes = append(boxes, []any{owned})
+		default:
+			boxes[len(boxes)-1] = append(boxes[len(boxes)-1].([]any), owned)
+		}
+	}
+
+	raw["storage"] = map[string]any{"party": party, "boxes": boxes, "last_id": len(names)}
+	delete(raw, "pokedex")
+	return nil
+}
+
+// addIndividuality rolls a nature and individual values for Pokémon caught
+// before they had them, from the save's seed so migrating the same save
+// always gives the same result. Their experience is filled in from their
+// level once their growth rate is known.
+func addIndividuality(raw map[string]any) error {
+	seed, err := seedOf(raw)
+	if err != nil {
+		return err
+	}
+	rng := rand.New(rand.NewPCG(seed, individualityStream))
+	for _, o := range ownedPokemon(raw) {
+		if _, ok := o["nature"]; !ok {
+			o["nature"] = game.RandomNature(rng)
+		}
+		if _, ok := o["ivs"]; !ok {
+			o["ivs"] = game.RollIVs(rng)
+		}
+		if _, ok := o["evs"]; !ok {
+			o["evs"] = map[string]int{}
+		}
+	}
+	return nil
+}
+
+// individualityStream keeps addIndividuality's random sequence apart from
+// the session's, which starts from the same seed.
+const individualityStream = 0x1f
+
+// seedOf reads the seed added by addSeed, which is a json.Number when it
+// comes from the file.
+func seedOf(raw map[string]any) (uint64, error) {
+	switch seed := raw["seed"].(type) {
+	case uint64:
+		return seed, nil
+	case json.Number:
+		n, err := strconv.ParseUint(seed.String(), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid seed %s", seed)
+		}
+		return n, nil
+	}
+	return 0, fmt.Errorf("missing seed")
+}
+
+// addFriendship gives Pokémon caught before friendship was tracked the
+// most common base friendship.
+func addFriendship(raw map[string]any) error {
+	for _, o := range ownedPokemon(raw) {
+		if _, ok := o["friendship"]; !ok {
+			o["friendship"] = legacyFriendship
+		}
+	}
+	return nil
+}
+
+// addItems gives saves from before evolution items were kept in the bag the
+// starting set of items.
+func addItems(raw map[string]any) error {
+	if _, ok := raw["items"]; !ok {
+		raw["items"] = game.StartingItems()
+	}
+	return nil
+}
+
+// ownedPokemon returns the owned Pokémon in the party and boxes of a raw
+// save from version 4 onwards.
+func ownedPokemon(raw map[string]any) []map[string]any {
+	storage, _ := raw["storage"].(map[string]any)
+	if storage == nil {
+		return nil
+	}
+	all := []any{}
+	if party, ok := storage["party"].([]any); ok {
+		all = append(all, party...)
+	}
+	if boxes, ok := storage["boxes"].([]any); ok {
+		for _, box := range boxes {
+			if box, ok := box.([]any); ok {
+				all = append(all, box...)
+			}
+		}
+	}
+
+	owned := []map[string]any{}
+	for _, o := range all {
+		if o, ok := o.(map[string]any); ok {
+			owned = append(owned, o)
+		}
+	}
+	return owned
+}
+
+func decode(data []byte) (*File, error) {
+	raw := map[string]any{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("save: corrupted save file: %w", err)
+	}
+
+	version := 0
+	if v, ok := raw["version"].(json.Number); ok {
+		n, err := v.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("save: invalid version %s", v)
+		}
+		version = int(n)
+	}
+	if version > CurrentVersion {
+		return nil, fmt.Errorf("save: save version %d is newer than supported version %d", version, CurrentVersion)
+	}
+	if err := migrate(raw, version); err != nil {
+		return nil, err
+	}
+
+	migrated, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	f := &File{}
+	if err := json.Unmarshal(migrated, f); err != nil {
+		return nil, fmt.Errorf("save: corrupted save file: %w", err)
+	}
+	if f.Storage == nil {
+		f.Storage = game.NewStorage()
+	}
+	if f.Storage.Party == nil {
+		f.Storage.Party = []*game.Owned{}
+	}
+	if f.Inventory == nil {
+		f.Inventory = game.Inventory{}
+	}
+	if f.Items == nil {
+		f.Items = game.Items{}
+	}
+	return f, nil
+}
+
+func migrate(raw map[string]any, from int) error {
+	for v := from; v < CurrentVersion; v++ {
+		if m, ok := migrations[v]; ok {
+			if err := m(raw); err != nil {
+				return fmt.Errorf("save: migrating from version %d: %w", v, err)
+			}
+		}
+		raw["version"] = v + 1
+	}
+	return nil
+}
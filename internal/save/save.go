@@ -0,0 +1,142 @@
+package save
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/game"
+)
+
+const CurrentVersion = 7
+const DefaultSlot = "default"
+const fileExt = ".json"
+
+var ErrNoSave = errors.New("save: slot does not exist")
+
+var slotPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// File is the on-disk format of a save slot. Fields added later must come
+// with a migration registered in migrations.
+type File struct {
+	Version   int            `json:"version"`
+	SavedAt   time.Time      `json:"saved_at"`
+	Storage   *game.Storage  `json:"storage"`
+	Inventory game.Inventory `json:"inventory"`
+	Items     game.Items     `json:"items"`
+	Seed      uint64         `json:"seed"`
+	RNGState  []byte         `json:"rng_state,omitempty"`
+}
+
+type SlotInfo struct {
+	Name    string
+	SavedAt time.Time
+	Caught  int
+}
+
+// Store reads and writes save slots in a directory, one file per slot.
+type Store struct {
+	dir string
+}
+
+// DefaultDir returns $XDG_DATA_HOME/pokedex/saves, falling back to
+// ~/.local/share/pokedex/saves.
+func DefaultDir() (string, error) {
+	dataHome := os.Getenv("XDG_DATA_HOME")
+	if dataHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dataHome = filepath.Join(home, ".local", "share")
+	}
+	return filepath.Join(dataHome, "pokedex", "saves"), nil
+}
+
+func NewStore(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &Store{dir: dir}, nil
+}
+
+func ValidSlot(slot string) bool {
+	return slotPattern.MatchString(slot)
+}
+
+func (s *Store) path(slot string) string {
+	return filepath.Join(s.dir, slot+fileExt)
+}
+
+func (s *Store) Save(slot string, f *File) error {
+	if !ValidSlot(slot) {
+		return fmt.Errorf("save: invalid slot name %q", slot)
+	}
+	f.Version = CurrentVersion
+	f.SavedAt = time.Now()
+
+	data, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(s.dir, slot+".tmp*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), s.path(slot))
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+func (s *Store) Load(slot string) (*File, error) {
+	if !ValidSlot(slot) {
+		return nil, fmt.Errorf("save: invalid slot name %q", slot)
+	}
+	data, err := os.ReadFile(s.path(slot))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNoSave
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decode(data)
+}
+
+func (s *Store) List() ([]SlotInfo, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	slots := []SlotInfo{}
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), fileExt)
+		if entry.IsDir() || !ok || !ValidSlot(name) {
+			continue
+		}
+		f, err := s.Load(name)
+		if err != nil {
+			continue
+		}
+		slots = append(slots, SlotInfo{Name: name, SavedAt: f.SavedAt, Caught: f.Storage.Len()})
+	}
+	sort.Slice(slots, func(i, j int) bool {
+		return slots[i].Name < slots[j].Name
+	})
+	return slots, nil
+}
@@ -0,0 +1,157 @@
+package save
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/game"
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+)
+
+func TestSaveLoad(t *testing.T) {
+	store, err := NewStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	storage := game.NewStorage()
+	storage.Add(game.Owned{Nickname: "sparky", Level: 12, Pokemon: pokeapi.PokemonResponse{Name: "pikachu", Height: 4, Weight: 60}})
+	f := &File{
+		Storage:   storage,
+		Inventory: game.Inventory{game.GreatBall: 3},
+		Seed:      18446744073709551557,
+	}
+	if err := store.Save(DefaultSlot, f); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	loaded, err := store.Load(DefaultSlot)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if loaded.Version != CurrentVersion {
+		t.Errorf("expected version %d, got %d", CurrentVersion, loaded.Version)
+	}
+	if matches := loaded.Storage.Find("sparky"); len(matches) != 1 || matches[0].Pokemon.Weight != 60 || matches[0].Level != 12 {
+		t.Errorf("expected to find pikachu in loaded save")
+	}
+	if loaded.Inventory[game.GreatBall] != 3 {
+		t.Errorf("expected 3 great balls, got %d", loaded.Inventory[game.GreatBall])
+	}
+	if loaded.Seed != 18446744073709551557 {
+		t.Errorf("expected seed to survive the round trip, got %d", loaded.Seed)
+	}
+}
+
+func TestLoadMissingAndInvalid(t *testing.T) {
+	dir := t.TempDir()
+	store, _ := NewStore(dir)
+
+	if _, err := store.Load("nothing"); err != ErrNoSave {
+		t.Errorf("expected ErrNoSave, got %v", err)
+	}
+	if _, err := store.Load("../escape"); err == nil {
+		t.Errorf("expected invalid slot name to be rejected")
+	}
+
+	os.WriteFile(filepath.Join(dir, "broken.json"), []byte("{"), 0644)
+	if _, err := store.Load("broken"); err == nil {
+		t.Errorf("expected corrupted save to fail to load")
+	}
+
+	os.WriteFile(filepath.Join(dir, "future.json"), []byte(`{"version":999}`), 0644)
+	if _, err := store.Load("future"); err == nil {
+		t.Errorf("expected newer save version to be rejected")
+	}
+}
+
+func TestMigration(t *testing.T) {
+	dir := t.TempDir()
+	store, _ := NewStore(dir)
+	pokedex := `"pidgey":{"name":"pidgey"}`
+	for _, name := range []string{"abra", "bulbasaur", "charmander", "diglett", "eevee", "farfetchd"} {
+		pokedex += `,"` + name + `":{"name":"` + name + `"}`
+	}
+	os.WriteFile(filepath.Join(dir, "old.json"), []byte(`{"pokedex":{`+pokedex+`}}`), 0644)
+
+	loaded, err := store.Load("old")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if loaded.Version != CurrentVersion {
+		t.Errorf("expected save to be migrated to version %d, got %d", CurrentVersion, loaded.Version)
+	}
+	pidgey := loaded.Storage.Find("pidgey")
+	if len(pidgey) != 1 || pidgey[0].ID != 7 || pidgey[0].Level != 50 {
+		t.Fatalf("expected to find pidgey in migrated save")
+	}
+	if box, _ := loaded.Storage.Locate(7); box != 1 || len(loaded.Storage.Party) != game.PartySize || loaded.Storage.LastID != 7 {
+		t.Errorf("expected the seventh pokemon to be moved to box 1, found it in %d", box)
+	}
+	if pidgey[0].Nature == "" || len(pidgey[0].IVs) != len(game.StatNames) {
+		t.Errorf("expected migrated pokemon to get a nature and IVs, got %q and %v", pidgey[0].Nature, pidgey[0].IVs)
+	}
+	if pidgey[0].Friendship != 70 {
+		t.Errorf("expected migrated pokemon to get 70 friendship, got %d", pidgey[0].Friendship)
+	}
+	if loaded.Inventory[game.PokeBall] != game.StartingInventory()[game.PokeBall] {
+		t.Errorf("expected migrated save to get the starting inventory")
+	}
+	if loaded.Items["moon-stone"] != 1 {
+		t.Errorf("expected migrated save to get the starting items, got %v", loaded.Items)
+	}
+}
+
+func TestList(t *testing.T) {
+	dir := t.TempDir()
+	store, _ := NewStore(dir)
+	storage := game.NewStorage()
+	storage.Add(game.Owned{Pokemon: pokeapi.PokemonResponse{Name: "pidgey"}})
+	store.Save("beta", &File{Storage: storage})
+	store.Save("alpha", &File{})
+	os.WriteFile(filepath.Join(dir, "broken.json"), []byte("{"), 0644)
+
+	slots, err := store.List()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(slots) != 2 || slots[0].Name != "alpha" || slots[1].Name != "beta" || slots[1].Caught != 1 {
+		t.Errorf("unexpected slots: %+v", slots)
+	}
+}
+
+func TestMigrationIsDeterministic(t *testing.T) {
+	dir := t.TempDir()
+	store, _ := NewStore(dir)
+	cases := []string{
+		`{"version":1,"saved_at":"2024-01-02T03:04:05Z","pokedex":{"pidgey":{"name":"pidgey"},"rattata":{"name":"rattata"}}}`,
+		`{"version":2,"saved_at":"2024-01-02T03:04:05Z","inventory":{"poke-ball":3},"pokedex":{"pidgey":{"name":"pidgey"},"rattata":{"name":"rattata"}}}`,
+		`{"version":3,"seed":18446744073709551557,"pokedex":{"pidgey":{"name":"pidgey"},"rattata":{"name":"rattata"}}}`,
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			os.WriteFile(filepath.Join(dir, "old.json"), []byte(c), 0644)
+			first, err := store.Load("old")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			second, err := store.Load("old")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if first.Seed != second.Seed {
+				t.Errorf("expected the same seed twice, got %d and %d", first.Seed, second.Seed)
+			}
+			for i, o := range first.Storage.All() {
+				other := second.Storage.All()[i]
+				if o.Nature != other.Nature || !maps.Equal(o.IVs, other.IVs) {
+					t.Errorf("expected %s to migrate the same way twice, got %s %v and %s %v", o.Pokemon.Name, o.Nature, o.IVs, other.Nature, other.IVs)
+				}
+			}
+		})
+	}
+}
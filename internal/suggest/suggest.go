@@ -0,0 +1,76 @@
+package suggest
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// Distance returns the edit distance between a and b, counting insertions,
+// deletions, substitutions and transpositions of adjacent characters.
+func Distance(a, b string) int {
+	ar := []rune(a)
+	br := []rune(b)
+
+	d := make([][]int, len(ar)+1)
+	for i := range d {
+		d[i] = make([]int, len(br)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(ar); i++ {
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ar[i-1] == br[j-2] && ar[i-2] == br[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(ar)][len(br)]
+}
+
+// MaxDistance is the largest edit distance still considered a typo of word.
+func MaxDistance(word string) int {
+	return max(1, utf8.RuneCountInString(word)/3)
+}
+
+// Closest returns up to limit candidates within MaxDistance of word, nearest
+// first. Exact matches are not returned.
+func Closest(word string, candidates []string, limit int) []string {
+	type match struct {
+		name     string
+		distance int
+	}
+
+	threshold := MaxDistance(word)
+	seen := map[string]bool{}
+	matches := []match{}
+	for _, c := range candidates {
+		if c == word || seen[c] {
+			continue
+		}
+		seen[c] = true
+		if d := Distance(word, c); d <= threshold {
+			matches = append(matches, match{name: c, distance: d})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+		return matches[i].name < matches[j].name
+	})
+
+	names := []string{}
+	for i := 0; i < len(matches) && i < limit; i++ {
+		names = append(names, matches[i].name)
+	}
+	return names
+}
@@ -0,0 +1,56 @@
+package suggest
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	cases := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{a: "", b: "", expected: 0},
+		{a: "", b: "abc", expected: 3},
+		{a: "pikachu", b: "pikachu", expected: 0},
+		{a: "pikachuu", b: "pikachu", expected: 1},
+		{a: "kitten", b: "sitting", expected: 3},
+		{a: "mpa", b: "map", expected: 1},
+		{a: "flabébé", b: "flabebe", expected: 2},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			if d := Distance(c.a, c.b); d != c.expected {
+				t.Errorf("Distance(%q, %q) = %d, expected %d", c.a, c.b, d, c.expected)
+			}
+		})
+	}
+}
+
+func TestClosest(t *testing.T) {
+	candidates := []string{"catch", "map", "mapb", "explore", "exit", "help", "history"}
+
+	cases := []struct {
+		word     string
+		expected []string
+	}{
+		{word: "catc", expected: []string{"catch"}},
+		{word: "mpa", expected: []string{"map"}},
+		{word: "maps", expected: []string{"map", "mapb"}},
+		{word: "exlpore", expected: []string{"explore"}},
+		{word: "zzzzzz", expected: []string{}},
+		{word: "help", expected: []string{}},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			actual := Closest(c.word, candidates, 3)
+			if !slices.Equal(actual, c.expected) {
+				t.Errorf("Closest(%q) = %v, expected %v", c.word, actual, c.expected)
+			}
+		})
+	}
+}
@@ -1,9 +1,70 @@
 package main
 
 import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokeapi"
+	"github.com/kartikey-tiwari/pokedex-go/internal/pokecache"
 	"github.com/kartikey-tiwari/pokedex-go/internal/repl"
+	"github.com/kartikey-tiwari/pokedex-go/internal/save"
 )
 
 func main() {
-	repl.StartREPL()
+	baseURL := os.Getenv("POKEAPI_BASE_URL")
+	if baseURL == "" {
+		baseURL = pokeapi.DefaultBaseURL
+	}
+	userAgent := os.Getenv("POKEAPI_USER_AGENT")
+	if userAgent == "" {
+		userAgent = pokeapi.DefaultUserAgent
+	}
+	cacheDir, err := pokecache.DefaultDir()
+	if err != nil {
+		cacheDir = ""
+	}
+	saveDir, err := save.DefaultDir()
+	if err != nil {
+		saveDir = ""
+	}
+
+	flag.StringVar(&baseURL, "api-url", baseURL, "base URL of the PokeAPI server (env POKEAPI_BASE_URL)")
+	flag.StringVar(&userAgent, "user-agent", userAgent, "User-Agent header sent with API requests (env POKEAPI_USER_AGENT)")
+	flag.StringVar(&cacheDir, "cache-dir", cacheDir, "directory for the persistent response cache, empty to disable")
+	cacheTTL := flag.Duration("cache-ttl", 7*24*time.Hour, "how long persisted responses stay valid")
+	cacheSize := flag.Int64("cache-size", 64<<20, "maximum size in bytes of the persistent cache, 0 for no limit")
+	cacheEntries := flag.Int("cache-entries", 256, "number of persisted responses also kept in memory")
+	flag.StringVar(&saveDir, "save-dir", saveDir, "directory for Pokedex save slots, empty to disable saving")
+	strict := flag.Bool("strict", false, "only allow catching pokemons that live in the current area")
+	seed := flag.Uint64("seed", 0, "seed for catches and encounters, overriding the one in the save")
+	flag.Parse()
+
+	var seedOption *uint64
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "seed" {
+			seedOption = seed
+		}
+	})
+
+	var client *pokeapi.Client
+	if cacheDir != "" {
+		files, err := pokecache.NewFileStore(cacheDir, *cacheTTL, *cacheSize)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Persistent cache disabled:", err)
+		} else {
+			client = pokeapi.NewClientWithCache(baseURL, pokecache.NewPersistentCache(*cacheEntries, files))
+		}
+	}
+	if client == nil {
+		client = pokeapi.NewClient(baseURL)
+	}
+	client.UserAgent = userAgent
+	repl.StartREPL(repl.Options{
+		Client:  client,
+		SaveDir: saveDir,
+		Seed:    seedOption,
+		Strict:  *strict,
+	})
 }